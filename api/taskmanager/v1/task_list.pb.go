@@ -0,0 +1,801 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: taskmanager/v1/task_list.proto
+
+package taskmanagerv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TaskList struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId      string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	WorkspaceId string                 `protobuf:"bytes,3,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	Title       string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	// Status is "todo" or "done".
+	Status string `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	// Priority is "low", "medium" or "high".
+	Priority    string                 `protobuf:"bytes,7,opt,name=priority,proto3" json:"priority,omitempty"`
+	DueDate     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	CompletedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Tags        []string               `protobuf:"bytes,11,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Reminder offsets are minutes before the due date at which the owner is reminded.
+	ReminderOffsets []int32 `protobuf:"varint,12,rep,packed,name=reminder_offsets,json=reminderOffsets,proto3" json:"reminder_offsets,omitempty"`
+	DependsOn       []int64 `protobuf:"varint,13,rep,packed,name=depends_on,json=dependsOn,proto3" json:"depends_on,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TaskList) Reset() {
+	*x = TaskList{}
+	mi := &file_taskmanager_v1_task_list_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskList) ProtoMessage() {}
+
+func (x *TaskList) ProtoReflect() protoreflect.Message {
+	mi := &file_taskmanager_v1_task_list_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskList.ProtoReflect.Descriptor instead.
+func (*TaskList) Descriptor() ([]byte, []int) {
+	return file_taskmanager_v1_task_list_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TaskList) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TaskList) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TaskList) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *TaskList) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *TaskList) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TaskList) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *TaskList) GetPriority() string {
+	if x != nil {
+		return x.Priority
+	}
+	return ""
+}
+
+func (x *TaskList) GetDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueDate
+	}
+	return nil
+}
+
+func (x *TaskList) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *TaskList) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TaskList) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *TaskList) GetReminderOffsets() []int32 {
+	if x != nil {
+		return x.ReminderOffsets
+	}
+	return nil
+}
+
+func (x *TaskList) GetDependsOn() []int64 {
+	if x != nil {
+		return x.DependsOn
+	}
+	return nil
+}
+
+type ListTaskListsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Tags  []string               `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Match all tags instead of any of them.
+	MatchAllTags  bool `protobuf:"varint,2,opt,name=match_all_tags,json=matchAllTags,proto3" json:"match_all_tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTaskListsRequest) Reset() {
+	*x = ListTaskListsRequest{}
+	mi := &file_taskmanager_v1_task_list_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTaskListsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTaskListsRequest) ProtoMessage() {}
+
+func (x *ListTaskListsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taskmanager_v1_task_list_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTaskListsRequest.ProtoReflect.Descriptor instead.
+func (*ListTaskListsRequest) Descriptor() ([]byte, []int) {
+	return file_taskmanager_v1_task_list_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListTaskListsRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ListTaskListsRequest) GetMatchAllTags() bool {
+	if x != nil {
+		return x.MatchAllTags
+	}
+	return false
+}
+
+type ListTaskListsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskLists     []*TaskList            `protobuf:"bytes,1,rep,name=task_lists,json=taskLists,proto3" json:"task_lists,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTaskListsResponse) Reset() {
+	*x = ListTaskListsResponse{}
+	mi := &file_taskmanager_v1_task_list_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTaskListsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTaskListsResponse) ProtoMessage() {}
+
+func (x *ListTaskListsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taskmanager_v1_task_list_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTaskListsResponse.ProtoReflect.Descriptor instead.
+func (*ListTaskListsResponse) Descriptor() ([]byte, []int) {
+	return file_taskmanager_v1_task_list_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListTaskListsResponse) GetTaskLists() []*TaskList {
+	if x != nil {
+		return x.TaskLists
+	}
+	return nil
+}
+
+type GetTaskListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaskListRequest) Reset() {
+	*x = GetTaskListRequest{}
+	mi := &file_taskmanager_v1_task_list_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskListRequest) ProtoMessage() {}
+
+func (x *GetTaskListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taskmanager_v1_task_list_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskListRequest.ProtoReflect.Descriptor instead.
+func (*GetTaskListRequest) Descriptor() ([]byte, []int) {
+	return file_taskmanager_v1_task_list_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetTaskListRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type CreateTaskListRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Title           string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description     string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Priority        string                 `protobuf:"bytes,3,opt,name=priority,proto3" json:"priority,omitempty"`
+	DueDate         *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	Tags            []string               `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	WorkspaceId     string                 `protobuf:"bytes,6,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	ReminderOffsets []int32                `protobuf:"varint,7,rep,packed,name=reminder_offsets,json=reminderOffsets,proto3" json:"reminder_offsets,omitempty"`
+	DependsOn       []int64                `protobuf:"varint,8,rep,packed,name=depends_on,json=dependsOn,proto3" json:"depends_on,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateTaskListRequest) Reset() {
+	*x = CreateTaskListRequest{}
+	mi := &file_taskmanager_v1_task_list_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTaskListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTaskListRequest) ProtoMessage() {}
+
+func (x *CreateTaskListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taskmanager_v1_task_list_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTaskListRequest.ProtoReflect.Descriptor instead.
+func (*CreateTaskListRequest) Descriptor() ([]byte, []int) {
+	return file_taskmanager_v1_task_list_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateTaskListRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateTaskListRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateTaskListRequest) GetPriority() string {
+	if x != nil {
+		return x.Priority
+	}
+	return ""
+}
+
+func (x *CreateTaskListRequest) GetDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueDate
+	}
+	return nil
+}
+
+func (x *CreateTaskListRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CreateTaskListRequest) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *CreateTaskListRequest) GetReminderOffsets() []int32 {
+	if x != nil {
+		return x.ReminderOffsets
+	}
+	return nil
+}
+
+func (x *CreateTaskListRequest) GetDependsOn() []int64 {
+	if x != nil {
+		return x.DependsOn
+	}
+	return nil
+}
+
+type CreateTaskListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTaskListResponse) Reset() {
+	*x = CreateTaskListResponse{}
+	mi := &file_taskmanager_v1_task_list_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTaskListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTaskListResponse) ProtoMessage() {}
+
+func (x *CreateTaskListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taskmanager_v1_task_list_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTaskListResponse.ProtoReflect.Descriptor instead.
+func (*CreateTaskListResponse) Descriptor() ([]byte, []int) {
+	return file_taskmanager_v1_task_list_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateTaskListResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type UpdateTaskListRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title       *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Description *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Status      *string                `protobuf:"bytes,4,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Priority    *string                `protobuf:"bytes,5,opt,name=priority,proto3,oneof" json:"priority,omitempty"`
+	DueDate     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	// An empty workspace ID moves the list back to its owner.
+	WorkspaceId   *string `protobuf:"bytes,7,opt,name=workspace_id,json=workspaceId,proto3,oneof" json:"workspace_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTaskListRequest) Reset() {
+	*x = UpdateTaskListRequest{}
+	mi := &file_taskmanager_v1_task_list_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTaskListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTaskListRequest) ProtoMessage() {}
+
+func (x *UpdateTaskListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taskmanager_v1_task_list_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTaskListRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTaskListRequest) Descriptor() ([]byte, []int) {
+	return file_taskmanager_v1_task_list_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateTaskListRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateTaskListRequest) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *UpdateTaskListRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateTaskListRequest) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *UpdateTaskListRequest) GetPriority() string {
+	if x != nil && x.Priority != nil {
+		return *x.Priority
+	}
+	return ""
+}
+
+func (x *UpdateTaskListRequest) GetDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueDate
+	}
+	return nil
+}
+
+func (x *UpdateTaskListRequest) GetWorkspaceId() string {
+	if x != nil && x.WorkspaceId != nil {
+		return *x.WorkspaceId
+	}
+	return ""
+}
+
+type UpdateTaskListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTaskListResponse) Reset() {
+	*x = UpdateTaskListResponse{}
+	mi := &file_taskmanager_v1_task_list_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTaskListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTaskListResponse) ProtoMessage() {}
+
+func (x *UpdateTaskListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taskmanager_v1_task_list_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTaskListResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTaskListResponse) Descriptor() ([]byte, []int) {
+	return file_taskmanager_v1_task_list_proto_rawDescGZIP(), []int{7}
+}
+
+type DeleteTaskListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTaskListRequest) Reset() {
+	*x = DeleteTaskListRequest{}
+	mi := &file_taskmanager_v1_task_list_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTaskListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTaskListRequest) ProtoMessage() {}
+
+func (x *DeleteTaskListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taskmanager_v1_task_list_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTaskListRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTaskListRequest) Descriptor() ([]byte, []int) {
+	return file_taskmanager_v1_task_list_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteTaskListRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteTaskListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTaskListResponse) Reset() {
+	*x = DeleteTaskListResponse{}
+	mi := &file_taskmanager_v1_task_list_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTaskListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTaskListResponse) ProtoMessage() {}
+
+func (x *DeleteTaskListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taskmanager_v1_task_list_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTaskListResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTaskListResponse) Descriptor() ([]byte, []int) {
+	return file_taskmanager_v1_task_list_proto_rawDescGZIP(), []int{9}
+}
+
+var File_taskmanager_v1_task_list_proto protoreflect.FileDescriptor
+
+const file_taskmanager_v1_task_list_proto_rawDesc = "" +
+	"\n" +
+	"\x1etaskmanager/v1/task_list.proto\x12\x0etaskmanager.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd1\x03\n" +
+	"\bTaskList\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12!\n" +
+	"\fworkspace_id\x18\x03 \x01(\tR\vworkspaceId\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x1a\n" +
+	"\bpriority\x18\a \x01(\tR\bpriority\x125\n" +
+	"\bdue_date\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\adueDate\x12=\n" +
+	"\fcompleted_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x12\n" +
+	"\x04tags\x18\v \x03(\tR\x04tags\x12)\n" +
+	"\x10reminder_offsets\x18\f \x03(\x05R\x0freminderOffsets\x12\x1d\n" +
+	"\n" +
+	"depends_on\x18\r \x03(\x03R\tdependsOn\"P\n" +
+	"\x14ListTaskListsRequest\x12\x12\n" +
+	"\x04tags\x18\x01 \x03(\tR\x04tags\x12$\n" +
+	"\x0ematch_all_tags\x18\x02 \x01(\bR\fmatchAllTags\"P\n" +
+	"\x15ListTaskListsResponse\x127\n" +
+	"\n" +
+	"task_lists\x18\x01 \x03(\v2\x18.taskmanager.v1.TaskListR\ttaskLists\"$\n" +
+	"\x12GetTaskListRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\xa3\x02\n" +
+	"\x15CreateTaskListRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bpriority\x18\x03 \x01(\tR\bpriority\x125\n" +
+	"\bdue_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\adueDate\x12\x12\n" +
+	"\x04tags\x18\x05 \x03(\tR\x04tags\x12!\n" +
+	"\fworkspace_id\x18\x06 \x01(\tR\vworkspaceId\x12)\n" +
+	"\x10reminder_offsets\x18\a \x03(\x05R\x0freminderOffsets\x12\x1d\n" +
+	"\n" +
+	"depends_on\x18\b \x03(\x03R\tdependsOn\"(\n" +
+	"\x16CreateTaskListResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\xc9\x02\n" +
+	"\x15UpdateTaskListRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\x05title\x18\x02 \x01(\tH\x00R\x05title\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01\x12\x1b\n" +
+	"\x06status\x18\x04 \x01(\tH\x02R\x06status\x88\x01\x01\x12\x1f\n" +
+	"\bpriority\x18\x05 \x01(\tH\x03R\bpriority\x88\x01\x01\x125\n" +
+	"\bdue_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\adueDate\x12&\n" +
+	"\fworkspace_id\x18\a \x01(\tH\x04R\vworkspaceId\x88\x01\x01B\b\n" +
+	"\x06_titleB\x0e\n" +
+	"\f_descriptionB\t\n" +
+	"\a_statusB\v\n" +
+	"\t_priorityB\x0f\n" +
+	"\r_workspace_id\"\x18\n" +
+	"\x16UpdateTaskListResponse\"'\n" +
+	"\x15DeleteTaskListRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\x18\n" +
+	"\x16DeleteTaskListResponse2\xdf\x03\n" +
+	"\x0fTaskListService\x12\\\n" +
+	"\rListTaskLists\x12$.taskmanager.v1.ListTaskListsRequest\x1a%.taskmanager.v1.ListTaskListsResponse\x12K\n" +
+	"\vGetTaskList\x12\".taskmanager.v1.GetTaskListRequest\x1a\x18.taskmanager.v1.TaskList\x12_\n" +
+	"\x0eCreateTaskList\x12%.taskmanager.v1.CreateTaskListRequest\x1a&.taskmanager.v1.CreateTaskListResponse\x12_\n" +
+	"\x0eUpdateTaskList\x12%.taskmanager.v1.UpdateTaskListRequest\x1a&.taskmanager.v1.UpdateTaskListResponse\x12_\n" +
+	"\x0eDeleteTaskList\x12%.taskmanager.v1.DeleteTaskListRequest\x1a&.taskmanager.v1.DeleteTaskListResponseB.Z,TaskManager/api/taskmanager/v1;taskmanagerv1b\x06proto3"
+
+var (
+	file_taskmanager_v1_task_list_proto_rawDescOnce sync.Once
+	file_taskmanager_v1_task_list_proto_rawDescData []byte
+)
+
+func file_taskmanager_v1_task_list_proto_rawDescGZIP() []byte {
+	file_taskmanager_v1_task_list_proto_rawDescOnce.Do(func() {
+		file_taskmanager_v1_task_list_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_taskmanager_v1_task_list_proto_rawDesc), len(file_taskmanager_v1_task_list_proto_rawDesc)))
+	})
+	return file_taskmanager_v1_task_list_proto_rawDescData
+}
+
+var file_taskmanager_v1_task_list_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_taskmanager_v1_task_list_proto_goTypes = []any{
+	(*TaskList)(nil),               // 0: taskmanager.v1.TaskList
+	(*ListTaskListsRequest)(nil),   // 1: taskmanager.v1.ListTaskListsRequest
+	(*ListTaskListsResponse)(nil),  // 2: taskmanager.v1.ListTaskListsResponse
+	(*GetTaskListRequest)(nil),     // 3: taskmanager.v1.GetTaskListRequest
+	(*CreateTaskListRequest)(nil),  // 4: taskmanager.v1.CreateTaskListRequest
+	(*CreateTaskListResponse)(nil), // 5: taskmanager.v1.CreateTaskListResponse
+	(*UpdateTaskListRequest)(nil),  // 6: taskmanager.v1.UpdateTaskListRequest
+	(*UpdateTaskListResponse)(nil), // 7: taskmanager.v1.UpdateTaskListResponse
+	(*DeleteTaskListRequest)(nil),  // 8: taskmanager.v1.DeleteTaskListRequest
+	(*DeleteTaskListResponse)(nil), // 9: taskmanager.v1.DeleteTaskListResponse
+	(*timestamppb.Timestamp)(nil),  // 10: google.protobuf.Timestamp
+}
+var file_taskmanager_v1_task_list_proto_depIdxs = []int32{
+	10, // 0: taskmanager.v1.TaskList.due_date:type_name -> google.protobuf.Timestamp
+	10, // 1: taskmanager.v1.TaskList.completed_at:type_name -> google.protobuf.Timestamp
+	10, // 2: taskmanager.v1.TaskList.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 3: taskmanager.v1.ListTaskListsResponse.task_lists:type_name -> taskmanager.v1.TaskList
+	10, // 4: taskmanager.v1.CreateTaskListRequest.due_date:type_name -> google.protobuf.Timestamp
+	10, // 5: taskmanager.v1.UpdateTaskListRequest.due_date:type_name -> google.protobuf.Timestamp
+	1,  // 6: taskmanager.v1.TaskListService.ListTaskLists:input_type -> taskmanager.v1.ListTaskListsRequest
+	3,  // 7: taskmanager.v1.TaskListService.GetTaskList:input_type -> taskmanager.v1.GetTaskListRequest
+	4,  // 8: taskmanager.v1.TaskListService.CreateTaskList:input_type -> taskmanager.v1.CreateTaskListRequest
+	6,  // 9: taskmanager.v1.TaskListService.UpdateTaskList:input_type -> taskmanager.v1.UpdateTaskListRequest
+	8,  // 10: taskmanager.v1.TaskListService.DeleteTaskList:input_type -> taskmanager.v1.DeleteTaskListRequest
+	2,  // 11: taskmanager.v1.TaskListService.ListTaskLists:output_type -> taskmanager.v1.ListTaskListsResponse
+	0,  // 12: taskmanager.v1.TaskListService.GetTaskList:output_type -> taskmanager.v1.TaskList
+	5,  // 13: taskmanager.v1.TaskListService.CreateTaskList:output_type -> taskmanager.v1.CreateTaskListResponse
+	7,  // 14: taskmanager.v1.TaskListService.UpdateTaskList:output_type -> taskmanager.v1.UpdateTaskListResponse
+	9,  // 15: taskmanager.v1.TaskListService.DeleteTaskList:output_type -> taskmanager.v1.DeleteTaskListResponse
+	11, // [11:16] is the sub-list for method output_type
+	6,  // [6:11] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_taskmanager_v1_task_list_proto_init() }
+func file_taskmanager_v1_task_list_proto_init() {
+	if File_taskmanager_v1_task_list_proto != nil {
+		return
+	}
+	file_taskmanager_v1_task_list_proto_msgTypes[6].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_taskmanager_v1_task_list_proto_rawDesc), len(file_taskmanager_v1_task_list_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_taskmanager_v1_task_list_proto_goTypes,
+		DependencyIndexes: file_taskmanager_v1_task_list_proto_depIdxs,
+		MessageInfos:      file_taskmanager_v1_task_list_proto_msgTypes,
+	}.Build()
+	File_taskmanager_v1_task_list_proto = out.File
+	file_taskmanager_v1_task_list_proto_goTypes = nil
+	file_taskmanager_v1_task_list_proto_depIdxs = nil
+}
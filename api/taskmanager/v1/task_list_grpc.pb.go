@@ -0,0 +1,289 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: taskmanager/v1/task_list.proto
+
+package taskmanagerv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	TaskListService_ListTaskLists_FullMethodName  = "/taskmanager.v1.TaskListService/ListTaskLists"
+	TaskListService_GetTaskList_FullMethodName    = "/taskmanager.v1.TaskListService/GetTaskList"
+	TaskListService_CreateTaskList_FullMethodName = "/taskmanager.v1.TaskListService/CreateTaskList"
+	TaskListService_UpdateTaskList_FullMethodName = "/taskmanager.v1.TaskListService/UpdateTaskList"
+	TaskListService_DeleteTaskList_FullMethodName = "/taskmanager.v1.TaskListService/DeleteTaskList"
+)
+
+// TaskListServiceClient is the client API for TaskListService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// TaskListService manages the task lists the caller can access.
+// Calls must carry a bearer token in the "authorization" metadata.
+type TaskListServiceClient interface {
+	// ListTaskLists returns the caller's task lists, optionally filtered by tags.
+	ListTaskLists(ctx context.Context, in *ListTaskListsRequest, opts ...grpc.CallOption) (*ListTaskListsResponse, error)
+	// GetTaskList returns a single task list.
+	GetTaskList(ctx context.Context, in *GetTaskListRequest, opts ...grpc.CallOption) (*TaskList, error)
+	// CreateTaskList creates a task list and returns its ID.
+	CreateTaskList(ctx context.Context, in *CreateTaskListRequest, opts ...grpc.CallOption) (*CreateTaskListResponse, error)
+	// UpdateTaskList changes the fields set in the request.
+	UpdateTaskList(ctx context.Context, in *UpdateTaskListRequest, opts ...grpc.CallOption) (*UpdateTaskListResponse, error)
+	// DeleteTaskList deletes a task list.
+	DeleteTaskList(ctx context.Context, in *DeleteTaskListRequest, opts ...grpc.CallOption) (*DeleteTaskListResponse, error)
+}
+
+type taskListServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTaskListServiceClient(cc grpc.ClientConnInterface) TaskListServiceClient {
+	return &taskListServiceClient{cc}
+}
+
+func (c *taskListServiceClient) ListTaskLists(ctx context.Context, in *ListTaskListsRequest, opts ...grpc.CallOption) (*ListTaskListsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTaskListsResponse)
+	err := c.cc.Invoke(ctx, TaskListService_ListTaskLists_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskListServiceClient) GetTaskList(ctx context.Context, in *GetTaskListRequest, opts ...grpc.CallOption) (*TaskList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskList)
+	err := c.cc.Invoke(ctx, TaskListService_GetTaskList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskListServiceClient) CreateTaskList(ctx context.Context, in *CreateTaskListRequest, opts ...grpc.CallOption) (*CreateTaskListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateTaskListResponse)
+	err := c.cc.Invoke(ctx, TaskListService_CreateTaskList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskListServiceClient) UpdateTaskList(ctx context.Context, in *UpdateTaskListRequest, opts ...grpc.CallOption) (*UpdateTaskListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateTaskListResponse)
+	err := c.cc.Invoke(ctx, TaskListService_UpdateTaskList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskListServiceClient) DeleteTaskList(ctx context.Context, in *DeleteTaskListRequest, opts ...grpc.CallOption) (*DeleteTaskListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTaskListResponse)
+	err := c.cc.Invoke(ctx, TaskListService_DeleteTaskList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TaskListServiceServer is the server API for TaskListService service.
+// All implementations must embed UnimplementedTaskListServiceServer
+// for forward compatibility.
+//
+// TaskListService manages the task lists the caller can access.
+// Calls must carry a bearer token in the "authorization" metadata.
+type TaskListServiceServer interface {
+	// ListTaskLists returns the caller's task lists, optionally filtered by tags.
+	ListTaskLists(context.Context, *ListTaskListsRequest) (*ListTaskListsResponse, error)
+	// GetTaskList returns a single task list.
+	GetTaskList(context.Context, *GetTaskListRequest) (*TaskList, error)
+	// CreateTaskList creates a task list and returns its ID.
+	CreateTaskList(context.Context, *CreateTaskListRequest) (*CreateTaskListResponse, error)
+	// UpdateTaskList changes the fields set in the request.
+	UpdateTaskList(context.Context, *UpdateTaskListRequest) (*UpdateTaskListResponse, error)
+	// DeleteTaskList deletes a task list.
+	DeleteTaskList(context.Context, *DeleteTaskListRequest) (*DeleteTaskListResponse, error)
+	mustEmbedUnimplementedTaskListServiceServer()
+}
+
+// UnimplementedTaskListServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTaskListServiceServer struct{}
+
+func (UnimplementedTaskListServiceServer) ListTaskLists(context.Context, *ListTaskListsRequest) (*ListTaskListsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTaskLists not implemented")
+}
+func (UnimplementedTaskListServiceServer) GetTaskList(context.Context, *GetTaskListRequest) (*TaskList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTaskList not implemented")
+}
+func (UnimplementedTaskListServiceServer) CreateTaskList(context.Context, *CreateTaskListRequest) (*CreateTaskListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTaskList not implemented")
+}
+func (UnimplementedTaskListServiceServer) UpdateTaskList(context.Context, *UpdateTaskListRequest) (*UpdateTaskListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTaskList not implemented")
+}
+func (UnimplementedTaskListServiceServer) DeleteTaskList(context.Context, *DeleteTaskListRequest) (*DeleteTaskListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTaskList not implemented")
+}
+func (UnimplementedTaskListServiceServer) mustEmbedUnimplementedTaskListServiceServer() {}
+func (UnimplementedTaskListServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeTaskListServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TaskListServiceServer will
+// result in compilation errors.
+type UnsafeTaskListServiceServer interface {
+	mustEmbedUnimplementedTaskListServiceServer()
+}
+
+func RegisterTaskListServiceServer(s grpc.ServiceRegistrar, srv TaskListServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTaskListServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TaskListService_ServiceDesc, srv)
+}
+
+func _TaskListService_ListTaskLists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTaskListsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskListServiceServer).ListTaskLists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskListService_ListTaskLists_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskListServiceServer).ListTaskLists(ctx, req.(*ListTaskListsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskListService_GetTaskList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaskListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskListServiceServer).GetTaskList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskListService_GetTaskList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskListServiceServer).GetTaskList(ctx, req.(*GetTaskListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskListService_CreateTaskList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTaskListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskListServiceServer).CreateTaskList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskListService_CreateTaskList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskListServiceServer).CreateTaskList(ctx, req.(*CreateTaskListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskListService_UpdateTaskList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTaskListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskListServiceServer).UpdateTaskList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskListService_UpdateTaskList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskListServiceServer).UpdateTaskList(ctx, req.(*UpdateTaskListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskListService_DeleteTaskList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTaskListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskListServiceServer).DeleteTaskList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskListService_DeleteTaskList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskListServiceServer).DeleteTaskList(ctx, req.(*DeleteTaskListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TaskListService_ServiceDesc is the grpc.ServiceDesc for TaskListService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TaskListService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "taskmanager.v1.TaskListService",
+	HandlerType: (*TaskListServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListTaskLists",
+			Handler:    _TaskListService_ListTaskLists_Handler,
+		},
+		{
+			MethodName: "GetTaskList",
+			Handler:    _TaskListService_GetTaskList_Handler,
+		},
+		{
+			MethodName: "CreateTaskList",
+			Handler:    _TaskListService_CreateTaskList_Handler,
+		},
+		{
+			MethodName: "UpdateTaskList",
+			Handler:    _TaskListService_UpdateTaskList_Handler,
+		},
+		{
+			MethodName: "DeleteTaskList",
+			Handler:    _TaskListService_DeleteTaskList_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "taskmanager/v1/task_list.proto",
+}
@@ -1,13 +1,18 @@
 package main
 
 import (
+	"TaskManager/internal/blobstore"
 	"TaskManager/internal/config"
+	"TaskManager/internal/grpcapi"
 	"TaskManager/internal/handlers"
+	"TaskManager/internal/notifier"
 	"TaskManager/internal/repository"
 	"TaskManager/internal/service"
+	"context"
 	"fmt"
 	"github.com/joho/godotenv"
 	"go.uber.org/zap"
+	"net"
 	"os"
 	"os/signal"
 	"syscall"
@@ -15,8 +20,6 @@ import (
 
 func main() {
 
-	cfg := config.MustLoad()
-
 	logger, _ := zap.NewDevelopment()
 	defer logger.Sync()
 
@@ -24,18 +27,84 @@ func main() {
 		logger.Fatal("Error loading .env file", zap.Error(err))
 	}
 
+	cfg := config.MustLoad()
+
+	keys := make([]service.KeySettings, 0, len(cfg.JWT.Keys))
+	for _, key := range cfg.JWT.Keys {
+		keys = append(keys, service.KeySettings{Id: key.Id, PrivateKeyFile: key.PrivateKeyFile, PublicKeyFile: key.PublicKeyFile})
+	}
+	tokenKeys, err := service.NewTokenKeys(service.TokenSettings{
+		Secret:     cfg.JWT.Secret,
+		Keys:       keys,
+		SigningKey: cfg.JWT.SigningKey,
+		TTL:        cfg.JWT.TTL,
+	})
+	if err != nil {
+		logger.Fatal("Failed to load token signing keys", zap.Error(err))
+	}
+
 	db, err := repository.NewMongo(os.Getenv("MONGODB_URI"))
 	if err != nil {
 		logger.Fatal("Failed to connect to MongoDB", zap.Error(err))
 	}
 
 	repo := repository.NewRepository(db, cfg.MongoDb)
+	if err := repo.EnsureIndexes(); err != nil {
+		logger.Fatal("Failed to create MongoDB indexes", zap.Error(err))
+	}
 	logger.Info(cfg.MongoDb)
-	services := service.NewService(repo)
-	handlers := handlers.NewHandler(services, logger)
+	var notifiers []notifier.Notifier
+	if cfg.Notifications.WebhookURL != "" {
+		notifiers = append(notifiers, notifier.NewWebhookNotifier(cfg.Notifications.WebhookURL))
+	}
+	if smtpCfg := cfg.Notifications.SMTP; smtpCfg.Host != "" {
+		notifiers = append(notifiers, notifier.NewSMTPNotifier(smtpCfg.Host, smtpCfg.Port, smtpCfg.Username, smtpCfg.Password, smtpCfg.From))
+	}
+
+	var blobs blobstore.BlobStore
+	switch attachCfg := cfg.Attachments; attachCfg.Store {
+	case "s3":
+		blobs, err = blobstore.NewS3Store(attachCfg.S3.Endpoint, attachCfg.S3.Region, attachCfg.S3.Bucket, attachCfg.S3.AccessKey, attachCfg.S3.SecretKey)
+	case "local":
+		blobs, err = blobstore.NewLocalStore(attachCfg.Dir)
+	default:
+		err = fmt.Errorf("unknown attachment store %q", attachCfg.Store)
+	}
+	if err != nil {
+		logger.Fatal("Failed to initialize attachment store", zap.Error(err))
+	}
+
+	services := service.NewService(repo, tokenKeys, notifiers, blobs, service.AttachmentLimits{
+		MaxSize:      cfg.Attachments.MaxSize,
+		AllowedTypes: cfg.Attachments.AllowedTypes,
+	})
+	if oidcCfg := cfg.OIDC; oidcCfg.Issuer != "" {
+		services.OIDC, err = service.NewOIDCService(context.Background(), service.OIDCSettings{
+			Issuer:       oidcCfg.Issuer,
+			ClientID:     oidcCfg.ClientID,
+			ClientSecret: oidcCfg.ClientSecret,
+			RedirectURL:  oidcCfg.RedirectURL,
+			Scopes:       oidcCfg.Scopes,
+			CreateUsers:  oidcCfg.CreateUsers,
+		}, repo.Authorization, tokenKeys)
+		if err != nil {
+			logger.Fatal("Failed to initialize OIDC login", zap.Error(err))
+		}
+	}
+	handlers := handlers.NewHandler(services, logger, handlers.Deprecation{
+		Date:   cfg.LegacyAPI.DeprecatedAt,
+		Sunset: cfg.LegacyAPI.SunsetAt,
+	})
 
 	e := handlers.InitRoutes(logger)
 
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go services.Recurrence.Run(ctx, cfg.RecurrenceInterval, logger)
+	go services.Reminder.Run(ctx, cfg.Notifications.Interval, logger)
+	go services.Webhook.Run(ctx, cfg.WebhookInterval, logger)
+
 	go func() {
 		logger.Info(fmt.Sprintf("Listening on port %s", cfg.Port))
 		if err := e.Start(cfg.Port); err != nil {
@@ -43,10 +112,23 @@ func main() {
 		}
 	}()
 
+	grpcServer := grpcapi.NewServer(services, logger)
+	go func() {
+		listener, err := net.Listen("tcp", cfg.GRPCPort)
+		if err != nil {
+			logger.Fatal("Failed to listen for gRPC", zap.Error(err))
+		}
+		logger.Info(fmt.Sprintf("gRPC listening on port %s", cfg.GRPCPort))
+		if err := grpcServer.Serve(listener); err != nil {
+			logger.Fatal("Failed to start gRPC server", zap.Error(err))
+		}
+	}()
+
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 
 	logger.Info("Shutting down server gracefully")
+	grpcServer.GracefulStop()
 
 }
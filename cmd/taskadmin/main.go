@@ -0,0 +1,363 @@
+// Command taskadmin performs administrative operations directly on the TaskManager database.
+//
+//	taskadmin [-config FILE] <command> [flags]
+//
+// The database name is read from the server config file and the connection string from the
+// MONGODB_URI environment variable, which may also be set in a .env file.
+package main
+
+import (
+	"TaskManager/internal/config"
+	"TaskManager/internal/domain/model"
+	"TaskManager/internal/repository"
+	"TaskManager/internal/service"
+	"bufio"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"github.com/joho/godotenv"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+const usage = `Usage: taskadmin [-config FILE] <command> [flags]
+
+Commands:
+  create-user      -username U [-password P] [-email E]
+  reset-password   -username U [-password P]
+  disable          -username U     prevent the user from logging in
+  enable           -username U     allow a disabled user to log in again
+  reset-2fa        -username U     turn off two-factor authentication for a user who lost their codes
+  link-identity    -username U -issuer I -subject S
+                                   let the user sign in with an account at an OpenID Connect provider
+  reassign         -from U -to U [-lists 1,2,3]
+  migrate                          create the database indexes
+  reseed-counters                  align the task list ID counter with the stored lists
+
+Passwords not given as flags are read from stdin.
+
+Global flags:
+`
+
+func main() {
+	if err := run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr); err != nil {
+		fmt.Fprintln(os.Stderr, "taskadmin:", err)
+		os.Exit(1)
+	}
+}
+
+// run connects to the database named in the config file and executes the requested command.
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	flags := flag.NewFlagSet("taskadmin", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	configPath := flags.String("config", os.Getenv("CONFIG_PATH"), "path to the server config file (env CONFIG_PATH)")
+	flags.Usage = func() {
+		fmt.Fprint(stderr, usage)
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() == 0 {
+		flags.Usage()
+		return fmt.Errorf("no command given")
+	}
+	if *configPath == "" {
+		return fmt.Errorf("config file path is empty")
+	}
+
+	cfg := config.MustLoadByPath(*configPath)
+	// The variables may also come from the environment, so a missing .env file is not an error.
+	_ = godotenv.Load(".env")
+
+	db, err := repository.NewMongo(os.Getenv("MONGODB_URI"))
+	if err != nil {
+		return fmt.Errorf("error connecting to MongoDB: %w", err)
+	}
+	defer db.Disconnect(context.Background())
+
+	repo := repository.NewRepository(db, cfg.MongoDb)
+	a := &admin{
+		repo:   repo,
+		auth:   service.NewAuthService(repo.Authorization, nil),
+		users:  service.NewUserAdminService(repo.Authorization),
+		stdin:  bufio.NewReader(stdin),
+		stdout: stdout,
+		stderr: stderr,
+	}
+	return a.execute(flags.Args())
+}
+
+// admin runs the commands against a repository.
+type admin struct {
+	repo   *repository.Repository
+	auth   *service.AuthService
+	users  *service.UserAdminService
+	stdin  *bufio.Reader
+	stdout io.Writer
+	stderr io.Writer
+}
+
+// execute runs the command named by the first argument.
+func (a *admin) execute(args []string) error {
+	name, rest := args[0], args[1:]
+	switch name {
+	case "create-user":
+		return a.createUser(rest)
+	case "reset-password":
+		return a.resetPassword(rest)
+	case "disable":
+		return a.setDisabled(rest, true)
+	case "enable":
+		return a.setDisabled(rest, false)
+	case "reset-2fa":
+		return a.resetTwoFactor(rest)
+	case "link-identity":
+		return a.linkIdentity(rest)
+	case "reassign":
+		return a.reassign(rest)
+	case "migrate":
+		return a.migrate(rest)
+	case "reseed-counters":
+		return a.reseedCounters(rest)
+	default:
+		return fmt.Errorf("unknown command %q", name)
+	}
+}
+
+// flagSet returns a flag set for a command that reports errors instead of exiting.
+func (a *admin) flagSet(name string) *flag.FlagSet {
+	flags := flag.NewFlagSet("taskadmin "+name, flag.ContinueOnError)
+	flags.SetOutput(a.stderr)
+	return flags
+}
+
+// createUser registers a user with the same validation as the register endpoint.
+func (a *admin) createUser(args []string) error {
+	flags := a.flagSet("create-user")
+	username := flags.String("username", "", "username")
+	password := flags.String("password", "", "password")
+	email := flags.String("email", "", "email address for notifications")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *username == "" {
+		return fmt.Errorf("create-user requires -username")
+	}
+	if err := a.readPassword(password); err != nil {
+		return err
+	}
+
+	if _, err := a.repo.Authorization.GetUserByUsername(*username); err == nil {
+		return fmt.Errorf("user %s already exists", *username)
+	}
+	if _, err := a.auth.CreateUser(model.User{Username: *username, Password: *password, Email: *email}); err != nil {
+		return err
+	}
+	user, err := a.repo.Authorization.GetUserByUsername(*username)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(a.stdout, "Created user %s (%s)\n", user.Username, user.Id.Hex())
+	return nil
+}
+
+// resetPassword sets a new password for a user.
+func (a *admin) resetPassword(args []string) error {
+	flags := a.flagSet("reset-password")
+	username := flags.String("username", "", "username")
+	password := flags.String("password", "", "new password")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	user, err := a.userByFlag(*username, "reset-password")
+	if err != nil {
+		return err
+	}
+	if err := a.readPassword(password); err != nil {
+		return err
+	}
+
+	if err := a.users.ResetPassword(user.Id.Hex(), *password); err != nil {
+		return err
+	}
+	fmt.Fprintf(a.stdout, "Reset the password of %s\n", user.Username)
+	return nil
+}
+
+// setDisabled disables or re-enables a user's account.
+// Tokens and personal access tokens the user already holds are rejected from then on.
+func (a *admin) setDisabled(args []string, disabled bool) error {
+	name := "enable"
+	if disabled {
+		name = "disable"
+	}
+	flags := a.flagSet(name)
+	username := flags.String("username", "", "username")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	user, err := a.userByFlag(*username, name)
+	if err != nil {
+		return err
+	}
+
+	if err := a.repo.Authorization.SetDisabled(user.Id.Hex(), disabled); err != nil {
+		return err
+	}
+	fmt.Fprintf(a.stdout, "%sd %s\n", strings.ToUpper(name[:1])+name[1:], user.Username)
+	return nil
+}
+
+// resetTwoFactor turns off two-factor authentication for a user, who can log in with their password
+// and enroll again.
+func (a *admin) resetTwoFactor(args []string) error {
+	flags := a.flagSet("reset-2fa")
+	username := flags.String("username", "", "username")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	user, err := a.userByFlag(*username, "reset-2fa")
+	if err != nil {
+		return err
+	}
+
+	if err := a.repo.Authorization.RemoveTwoFactor(user.Id.Hex()); err != nil {
+		return err
+	}
+	fmt.Fprintf(a.stdout, "Reset two-factor authentication of %s\n", user.Username)
+	return nil
+}
+
+// linkIdentity links an account at an OpenID Connect provider to an existing user, who can sign in with it
+// from then on. Accounts are never linked by email address, so this is how an existing user adopts single sign-on.
+func (a *admin) linkIdentity(args []string) error {
+	flags := a.flagSet("link-identity")
+	username := flags.String("username", "", "username")
+	issuer := flags.String("issuer", "", "issuer URL of the provider")
+	subject := flags.String("subject", "", "subject of the account at the provider")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *issuer == "" || *subject == "" {
+		return fmt.Errorf("link-identity requires -issuer and -subject")
+	}
+	user, err := a.userByFlag(*username, "link-identity")
+	if err != nil {
+		return err
+	}
+
+	identity := model.ExternalIdentity{Issuer: *issuer, Subject: *subject}
+	linked, err := a.repo.Authorization.GetUserByIdentity(identity)
+	if err == nil {
+		if linked.Id == user.Id {
+			fmt.Fprintf(a.stdout, "The identity is already linked to %s\n", user.Username)
+			return nil
+		}
+		return fmt.Errorf("the identity is already linked to %s", linked.Username)
+	}
+	if !errors.Is(err, repository.ErrUserNotFound) {
+		return err
+	}
+
+	if err := a.repo.Authorization.AddIdentity(user.Id.Hex(), identity); err != nil {
+		return err
+	}
+	fmt.Fprintf(a.stdout, "Linked the identity to %s\n", user.Username)
+	return nil
+}
+
+// reassign moves task lists from one user to another.
+func (a *admin) reassign(args []string) error {
+	flags := a.flagSet("reassign")
+	from := flags.String("from", "", "username of the current owner")
+	to := flags.String("to", "", "username of the new owner")
+	lists := flags.String("lists", "", "comma-separated task list IDs (default all lists of -from)")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *from == "" || *to == "" {
+		return fmt.Errorf("reassign requires -from and -to")
+	}
+
+	var listIds []int
+	if *lists != "" {
+		for _, value := range strings.Split(*lists, ",") {
+			id, err := strconv.Atoi(strings.TrimSpace(value))
+			if err != nil {
+				return fmt.Errorf("invalid task list ID %q", value)
+			}
+			listIds = append(listIds, id)
+		}
+	}
+
+	fromUser, err := a.repo.Authorization.GetUserByUsername(*from)
+	if err != nil {
+		return fmt.Errorf("%s: %w", *from, err)
+	}
+	toUser, err := a.repo.Authorization.GetUserByUsername(*to)
+	if err != nil {
+		return fmt.Errorf("%s: %w", *to, err)
+	}
+
+	moved, err := a.repo.TaskList.Reassign(fromUser.Id.Hex(), toUser.Id.Hex(), listIds)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(a.stdout, "Reassigned %d task lists from %s to %s\n", moved, fromUser.Username, toUser.Username)
+	return nil
+}
+
+// migrate creates the indexes the server relies on. It is safe to run repeatedly.
+func (a *admin) migrate(args []string) error {
+	if err := a.flagSet("migrate").Parse(args); err != nil {
+		return err
+	}
+	if err := a.repo.EnsureIndexes(); err != nil {
+		return err
+	}
+	fmt.Fprintln(a.stdout, "Indexes are up to date")
+	return nil
+}
+
+// reseedCounters sets the task list ID counter to the highest stored task list ID.
+func (a *admin) reseedCounters(args []string) error {
+	if err := a.flagSet("reseed-counters").Parse(args); err != nil {
+		return err
+	}
+	last, err := a.repo.TaskList.ReseedCounter()
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(a.stdout, "Task list counter set to %d\n", last)
+	return nil
+}
+
+// userByFlag looks up the user named by a required -username flag.
+func (a *admin) userByFlag(username, command string) (model.User, error) {
+	if username == "" {
+		return model.User{}, fmt.Errorf("%s requires -username", command)
+	}
+	user, err := a.repo.Authorization.GetUserByUsername(username)
+	if err != nil {
+		return model.User{}, fmt.Errorf("%s: %w", username, err)
+	}
+	return user, nil
+}
+
+// readPassword reads the password from stdin when it was not given as a flag.
+func (a *admin) readPassword(password *string) error {
+	if *password != "" {
+		return nil
+	}
+	fmt.Fprint(a.stderr, "Password: ")
+	line, err := a.stdin.ReadString('\n')
+	if err != nil && err != io.EOF {
+		return fmt.Errorf("error reading password: %w", err)
+	}
+	*password = strings.TrimRight(line, "\r\n")
+	return nil
+}
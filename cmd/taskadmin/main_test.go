@@ -0,0 +1,161 @@
+package main
+
+import (
+	"TaskManager/internal/domain/model"
+	"TaskManager/internal/repository"
+	"TaskManager/internal/service"
+	"bufio"
+	"bytes"
+	"errors"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+)
+
+type fakeUsers struct {
+	repository.Authorization
+	users map[string]*model.User
+}
+
+func (f *fakeUsers) CreateUser(user model.User) (int, error) {
+	user.Id = bson.NewObjectID()
+	f.users[user.Username] = &user
+	return 1, nil
+}
+
+func (f *fakeUsers) GetUserByUsername(username string) (model.User, error) {
+	user, ok := f.users[username]
+	if !ok {
+		return model.User{}, errors.New("user not found")
+	}
+	return *user, nil
+}
+
+func (f *fakeUsers) byId(id string) *model.User {
+	for _, user := range f.users {
+		if user.Id.Hex() == id {
+			return user
+		}
+	}
+	return nil
+}
+
+func (f *fakeUsers) SetPassword(id string, passwordHash string) error {
+	f.byId(id).Password = passwordHash
+	return nil
+}
+
+func (f *fakeUsers) SetDisabled(id string, disabled bool) error {
+	f.byId(id).Disabled = disabled
+	return nil
+}
+
+func (f *fakeUsers) RemoveTwoFactor(id string) error {
+	f.byId(id).TwoFactor = nil
+	return nil
+}
+
+func (f *fakeUsers) GetUserByIdentity(identity model.ExternalIdentity) (model.User, error) {
+	for _, user := range f.users {
+		if slices.Contains(user.Identities, identity) {
+			return *user, nil
+		}
+	}
+	return model.User{}, repository.ErrUserNotFound
+}
+
+func (f *fakeUsers) AddIdentity(id string, identity model.ExternalIdentity) error {
+	user := f.byId(id)
+	user.Identities = append(user.Identities, identity)
+	return nil
+}
+
+type fakeTaskLists struct {
+	repository.TaskList
+	from, to string
+	listIds  []int
+}
+
+func (f *fakeTaskLists) Reassign(fromUserId, toUserId string, listIds []int) (int64, error) {
+	f.from, f.to, f.listIds = fromUserId, toUserId, listIds
+	return int64(len(listIds)), nil
+}
+
+func TestAdmin(t *testing.T) {
+	users := &fakeUsers{users: map[string]*model.User{}}
+	lists := &fakeTaskLists{}
+	repo := &repository.Repository{Authorization: users, TaskList: lists}
+	var stdout bytes.Buffer
+	a := &admin{
+		repo:   repo,
+		auth:   service.NewAuthService(repo.Authorization, nil),
+		users:  service.NewUserAdminService(repo.Authorization),
+		stdin:  bufio.NewReader(strings.NewReader("secret_1\n")),
+		stdout: &stdout,
+		stderr: &bytes.Buffer{},
+	}
+
+	if err := a.execute([]string{"create-user", "-username", "alice"}); err != nil {
+		t.Fatalf("create-user error = %v", err)
+	}
+	if err := a.execute([]string{"create-user", "-username", "alice", "-password", "secret_2"}); err == nil {
+		t.Error("create-user of an existing username expected an error")
+	}
+	if err := a.execute([]string{"create-user", "-username", "bob", "-password", "short"}); err == nil {
+		t.Error("create-user with an invalid password expected an error")
+	}
+	if err := a.execute([]string{"create-user", "-username", "bob", "-password", "secret_2"}); err != nil {
+		t.Fatalf("create-user error = %v", err)
+	}
+
+	oldHash := users.users["alice"].Password
+	if err := a.execute([]string{"reset-password", "-username", "alice", "-password", "secret_3"}); err != nil {
+		t.Fatalf("reset-password error = %v", err)
+	}
+	if users.users["alice"].Password == oldHash {
+		t.Error("reset-password did not change the password hash")
+	}
+
+	if err := a.execute([]string{"disable", "-username", "bob"}); err != nil || !users.users["bob"].Disabled {
+		t.Errorf("disable = %v, disabled %v", err, users.users["bob"].Disabled)
+	}
+	if err := a.execute([]string{"enable", "-username", "bob"}); err != nil || users.users["bob"].Disabled {
+		t.Errorf("enable = %v, disabled %v", err, users.users["bob"].Disabled)
+	}
+
+	users.users["bob"].TwoFactor = &model.TwoFactor{Secret: "JBSWY3DPEHPK3PXP", Enabled: true}
+	if err := a.execute([]string{"reset-2fa", "-username", "bob"}); err != nil || users.users["bob"].TwoFactor != nil {
+		t.Errorf("reset-2fa = %v, two-factor %+v", err, users.users["bob"].TwoFactor)
+	}
+
+	link := []string{"link-identity", "-username", "bob", "-issuer", "https://idp.example.com", "-subject", "b-1"}
+	if err := a.execute(link); err != nil {
+		t.Fatalf("link-identity error = %v", err)
+	}
+	if want := []model.ExternalIdentity{{Issuer: "https://idp.example.com", Subject: "b-1"}}; !reflect.DeepEqual(users.users["bob"].Identities, want) {
+		t.Errorf("identities of bob = %+v, want %+v", users.users["bob"].Identities, want)
+	}
+	if err := a.execute(link); err != nil || len(users.users["bob"].Identities) != 1 {
+		t.Errorf("linking the same identity again = %v, identities %+v", err, users.users["bob"].Identities)
+	}
+	link[2] = "alice"
+	if err := a.execute(link); err == nil || len(users.users["alice"].Identities) != 0 {
+		t.Errorf("linking an identity of another user = %v, identities %+v", err, users.users["alice"].Identities)
+	}
+
+	if err := a.execute([]string{"reassign", "-from", "alice", "-to", "bob", "-lists", "3, 5"}); err != nil {
+		t.Fatalf("reassign error = %v", err)
+	}
+	if lists.from != users.users["alice"].Id.Hex() || lists.to != users.users["bob"].Id.Hex() || !reflect.DeepEqual(lists.listIds, []int{3, 5}) {
+		t.Errorf("Reassign(%s, %s, %v)", lists.from, lists.to, lists.listIds)
+	}
+	if err := a.execute([]string{"reassign", "-from", "alice", "-to", "carol"}); err == nil {
+		t.Error("reassign to an unknown user expected an error")
+	}
+
+	if !strings.Contains(stdout.String(), "Reassigned 2 task lists from alice to bob") {
+		t.Errorf("output = %q", stdout.String())
+	}
+}
@@ -0,0 +1,298 @@
+package main
+
+import (
+	"TaskManager/pkg/client"
+	"bufio"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"golang.org/x/term"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// command holds what every subcommand needs.
+type command struct {
+	cfg        config
+	configPath string
+	serverURL  string
+	client     *client.Client
+	output     string
+	stdin      io.Reader
+	stdout     io.Writer
+	stderr     io.Writer
+}
+
+// flagSet returns a flag set for a subcommand that reports errors instead of exiting.
+func (c *command) flagSet(name string) *flag.FlagSet {
+	flags := flag.NewFlagSet("taskctl "+name, flag.ContinueOnError)
+	flags.SetOutput(c.stderr)
+	return flags
+}
+
+// login exchanges credentials for a token and stores it together with the server URL.
+// Users with two-factor authentication are asked for a code unless -code is given.
+func (c *command) login(args []string) error {
+	flags := c.flagSet("login")
+	username := flags.String("u", "", "username")
+	password := flags.String("p", "", "password (read from stdin when omitted)")
+	code := flags.String("code", "", "two-factor code or recovery code (read from stdin when needed and omitted)")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *username == "" {
+		return fmt.Errorf("login requires -u")
+	}
+	// One reader for both prompts, so the code is not lost in the buffer of the password prompt.
+	stdin := bufio.NewReader(c.stdin)
+	if *password == "" {
+		line, err := c.promptPassword(stdin, "Password: ")
+		if err != nil {
+			return fmt.Errorf("error reading password: %w", err)
+		}
+		*password = line
+	}
+
+	token, err := c.client.Login(context.Background(), *username, *password)
+	var twoFactor *client.TwoFactorRequiredError
+	if errors.As(err, &twoFactor) {
+		if *code == "" {
+			line, err := c.prompt(stdin, "Two-factor code: ")
+			if err != nil {
+				return fmt.Errorf("error reading two-factor code: %w", err)
+			}
+			*code = line
+		}
+		token, err = c.client.LoginTwoFactor(context.Background(), twoFactor.Challenge, *code)
+	}
+	if err != nil {
+		return err
+	}
+
+	c.cfg.Server = c.serverURL
+	c.cfg.Token = token
+	if err := saveConfig(c.configPath, c.cfg); err != nil {
+		return err
+	}
+	fmt.Fprintf(c.stdout, "Logged in to %s as %s\n", c.serverURL, *username)
+	return nil
+}
+
+// prompt writes the label to stderr and reads a line from stdin.
+func (c *command) prompt(stdin *bufio.Reader, label string) (string, error) {
+	fmt.Fprint(c.stderr, label)
+	line, err := stdin.ReadString('\n')
+	if err != nil && err != io.EOF {
+		return "", err
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+// promptPassword reads a password without echoing it when stdin is a terminal,
+// and reads a line like prompt otherwise, e.g. when the password is piped in.
+func (c *command) promptPassword(stdin *bufio.Reader, label string) (string, error) {
+	file, ok := c.stdin.(*os.File)
+	if !ok || !term.IsTerminal(int(file.Fd())) {
+		return c.prompt(stdin, label)
+	}
+	fmt.Fprint(c.stderr, label)
+	password, err := term.ReadPassword(int(file.Fd()))
+	fmt.Fprintln(c.stderr)
+	if err != nil {
+		return "", err
+	}
+	return string(password), nil
+}
+
+// logout removes the stored token.
+func (c *command) logout(args []string) error {
+	if err := c.flagSet("logout").Parse(args); err != nil {
+		return err
+	}
+	c.cfg.Token = ""
+	return saveConfig(c.configPath, c.cfg)
+}
+
+// list prints the user's task lists, optionally filtered by tags.
+func (c *command) list(args []string) error {
+	flags := c.flagSet("list")
+	tags := flags.String("tags", "", "comma-separated tags to filter by")
+	all := flags.Bool("all", false, "require every tag instead of any of them")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	opts := client.ListTasksOptions{MatchAllTags: *all}
+	if *tags != "" {
+		opts.Tags = strings.Split(*tags, ",")
+	}
+
+	lists, err := c.client.ListTasks(context.Background(), opts)
+	if err != nil {
+		return err
+	}
+	if c.output == outputJSON {
+		return writeJSON(c.stdout, lists)
+	}
+	return writeTaskListTable(c.stdout, lists)
+}
+
+// get prints a single task list.
+func (c *command) get(args []string) error {
+	id, err := c.parseId("get", args)
+	if err != nil {
+		return err
+	}
+
+	list, err := c.client.GetTask(context.Background(), id)
+	if err != nil {
+		return err
+	}
+	if c.output == outputJSON {
+		return writeJSON(c.stdout, list)
+	}
+	return writeTaskListDetails(c.stdout, list)
+}
+
+// create adds a task list and prints its ID.
+func (c *command) create(args []string) error {
+	flags := c.flagSet("create")
+	title := flags.String("title", "", "title of the list")
+	description := flags.String("description", "", "description of the list")
+	priority := flags.String("priority", "", "low, medium or high")
+	due := flags.String("due", "", "due date, RFC 3339 or YYYY-MM-DD")
+	tags := flags.String("tags", "", "comma-separated tags")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *title == "" {
+		return fmt.Errorf("create requires -title")
+	}
+
+	list := client.TaskList{Title: *title, Description: *description, Priority: *priority}
+	if *due != "" {
+		dueDate, err := parseDue(*due)
+		if err != nil {
+			return err
+		}
+		list.DueDate = &dueDate
+	}
+	if *tags != "" {
+		list.Tags = strings.Split(*tags, ",")
+	}
+
+	id, err := c.client.CreateTask(context.Background(), list)
+	if err != nil {
+		return err
+	}
+	return c.report(id, "created")
+}
+
+// update changes the fields given as flags and leaves the others untouched.
+func (c *command) update(args []string) error {
+	flags := c.flagSet("update")
+	title := flags.String("title", "", "new title")
+	description := flags.String("description", "", "new description")
+	status := flags.String("status", "", "todo or done")
+	priority := flags.String("priority", "", "low, medium or high")
+	due := flags.String("due", "", "due date, RFC 3339 or YYYY-MM-DD")
+	id, err := c.parseIdWithFlags(flags, args)
+	if err != nil {
+		return err
+	}
+
+	var input client.UpdateTaskInput
+	var visitErr error
+	flags.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "title":
+			input.Title = title
+		case "description":
+			input.Description = description
+		case "status":
+			input.Status = status
+		case "priority":
+			input.Priority = priority
+		case "due":
+			dueDate, err := parseDue(*due)
+			if err != nil {
+				visitErr = err
+				return
+			}
+			input.DueDate = &dueDate
+		}
+	})
+	if visitErr != nil {
+		return visitErr
+	}
+	if input == (client.UpdateTaskInput{}) {
+		return fmt.Errorf("update requires at least one field flag")
+	}
+
+	if err := c.client.UpdateTask(context.Background(), id, input); err != nil {
+		return err
+	}
+	return c.report(id, "updated")
+}
+
+// delete removes a task list.
+func (c *command) delete(args []string) error {
+	id, err := c.parseId("delete", args)
+	if err != nil {
+		return err
+	}
+	if err := c.client.DeleteTask(context.Background(), id); err != nil {
+		return err
+	}
+	return c.report(id, "deleted")
+}
+
+// report prints the outcome of a change to a task list.
+func (c *command) report(id int, status string) error {
+	if c.output == outputJSON {
+		return writeJSON(c.stdout, map[string]interface{}{"id": id, "status": status})
+	}
+	fmt.Fprintf(c.stdout, "%s task list %d\n", strings.ToUpper(status[:1])+status[1:], id)
+	return nil
+}
+
+// parseId parses the arguments of a subcommand that takes only a task list ID.
+func (c *command) parseId(name string, args []string) (int, error) {
+	return c.parseIdWithFlags(c.flagSet(name), args)
+}
+
+// parseIdWithFlags parses the flags and the task list ID, which may come before or after them.
+func (c *command) parseIdWithFlags(flags *flag.FlagSet, args []string) (int, error) {
+	var rest []string
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		rest, args = args[:1], args[1:]
+	}
+	if err := flags.Parse(args); err != nil {
+		return 0, err
+	}
+	rest = append(rest, flags.Args()...)
+	if len(rest) != 1 {
+		return 0, fmt.Errorf("%s requires exactly one task list ID", flags.Name())
+	}
+	id, err := strconv.Atoi(rest[0])
+	if err != nil {
+		return 0, fmt.Errorf("invalid task list ID %q", rest[0])
+	}
+	return id, nil
+}
+
+// parseDue accepts a full RFC 3339 timestamp or a date, which is taken as midnight UTC.
+func parseDue(value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid due date %q: use RFC 3339 or YYYY-MM-DD", value)
+	}
+	return t, nil
+}
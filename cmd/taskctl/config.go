@@ -0,0 +1,60 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// config is the state taskctl keeps between invocations.
+type config struct {
+	Server string `json:"server,omitempty"`
+	Token  string `json:"token,omitempty"`
+}
+
+// defaultConfigPath returns taskctl/config.json under the user's config directory.
+func defaultConfigPath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("error locating config directory: %w", err)
+	}
+	return filepath.Join(dir, "taskctl", "config.json"), nil
+}
+
+// loadConfig reads the config file. A missing file yields an empty config.
+func loadConfig(path string) (config, error) {
+	var cfg config
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return cfg, nil
+	}
+	if err != nil {
+		return cfg, fmt.Errorf("error reading config: %w", err)
+	}
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return cfg, fmt.Errorf("error parsing config %s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+// saveConfig writes the config file readable only by its owner, since it holds the token.
+func saveConfig(path string, cfg config) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("error creating config directory: %w", err)
+	}
+	data, err := json.MarshalIndent(cfg, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error encoding config: %w", err)
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0o600); err != nil {
+		return fmt.Errorf("error writing config: %w", err)
+	}
+	// WriteFile keeps the mode of an existing file, which may predate the token.
+	if err := os.Chmod(path, 0o600); err != nil {
+		return fmt.Errorf("error restricting config permissions: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+// Command taskctl manages TaskManager task lists from the terminal.
+//
+//	taskctl [-server URL] [-config FILE] [-o table|json] <command> [flags] [args]
+//
+// The server URL is taken from -server, then the TASKCTL_SERVER environment variable, then the
+// server of the last login. The token obtained by "taskctl login" is stored in the config file,
+// by default taskctl/config.json under the user's config directory (TASKCTL_CONFIG overrides it).
+package main
+
+import (
+	"TaskManager/pkg/client"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+)
+
+const (
+	serverEnv     = "TASKCTL_SERVER"
+	configEnv     = "TASKCTL_CONFIG"
+	defaultServer = "http://localhost:8080"
+)
+
+const usage = `Usage: taskctl [-server URL] [-config FILE] [-o table|json] <command> [flags] [args]
+
+Commands:
+  login   -u USERNAME [-p PASSWORD] [-code C]   log in and store the token
+  logout                                        forget the stored token
+  list    [-tags a,b] [-all]                    list task lists
+  get     ID                                    show a task list
+  create  -title T [-description D] [-priority P] [-due RFC3339]
+  update  ID [-title T] [-description D] [-status S] [-priority P] [-due RFC3339]
+  delete  ID                                    delete a task list
+
+Global flags:
+`
+
+func main() {
+	if err := run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr); err != nil {
+		fmt.Fprintln(os.Stderr, "taskctl:", err)
+		os.Exit(1)
+	}
+}
+
+// run parses the global flags and executes the requested command.
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	flags := flag.NewFlagSet("taskctl", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	server := flags.String("server", os.Getenv(serverEnv), "TaskManager server URL (env "+serverEnv+")")
+	configPath := flags.String("config", os.Getenv(configEnv), "config file holding the token (env "+configEnv+")")
+	output := flags.String("o", outputTable, "output format: table or json")
+	flags.Usage = func() {
+		fmt.Fprint(stderr, usage)
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() == 0 {
+		flags.Usage()
+		return fmt.Errorf("no command given")
+	}
+	if *output != outputTable && *output != outputJSON {
+		return fmt.Errorf("unknown output format %q", *output)
+	}
+
+	if *configPath == "" {
+		path, err := defaultConfigPath()
+		if err != nil {
+			return err
+		}
+		*configPath = path
+	}
+	cfg, err := loadConfig(*configPath)
+	if err != nil {
+		return err
+	}
+
+	serverURL := *server
+	if serverURL == "" {
+		serverURL = cfg.Server
+	}
+	if serverURL == "" {
+		serverURL = defaultServer
+	}
+
+	cmd := &command{
+		cfg:        cfg,
+		configPath: *configPath,
+		serverURL:  serverURL,
+		client:     client.New(serverURL, client.WithToken(cfg.Token)),
+		output:     *output,
+		stdin:      stdin,
+		stdout:     stdout,
+		stderr:     stderr,
+	}
+
+	err = cmd.run(flags.Arg(0), flags.Args()[1:])
+	if errors.Is(err, client.ErrUnauthorized) {
+		return fmt.Errorf("%w (run taskctl login)", err)
+	}
+	return err
+}
+
+// run executes the named subcommand.
+func (c *command) run(name string, args []string) error {
+	switch name {
+	case "login":
+		return c.login(args)
+	case "logout":
+		return c.logout(args)
+	case "list":
+		return c.list(args)
+	case "get":
+		return c.get(args)
+	case "create":
+		return c.create(args)
+	case "update":
+		return c.update(args)
+	case "delete":
+		return c.delete(args)
+	default:
+		fmt.Fprint(c.stderr, usage)
+		return fmt.Errorf("unknown command %q", name)
+	}
+}
@@ -0,0 +1,158 @@
+package main
+
+import (
+	"TaskManager/internal/domain/model"
+	"TaskManager/internal/handlers"
+	"TaskManager/internal/service"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"go.uber.org/zap"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+type fakeAuth struct {
+	service.Authorization
+}
+
+func (fakeAuth) GenerateToken(username, password string) (string, error) {
+	if username != "alice" || password != "secret" {
+		return "", errors.New("invalid credentials")
+	}
+	return "token-1", nil
+}
+
+func (fakeAuth) ParseToken(token string) (string, error) {
+	if token != "token-1" {
+		return "", errors.New("invalid token")
+	}
+	return "alice-id", nil
+}
+
+func (fakeAuth) ParseTokenScopes(token string) (string, []string, error) {
+	userId, err := fakeAuth{}.ParseToken(token)
+	return userId, model.Scopes, err
+}
+
+type fakeTaskLists struct {
+	service.TaskList
+	lists   []model.TaskList
+	updated model.UpdateTaskListInput
+	deleted int
+}
+
+func (f *fakeTaskLists) GetAll(userId string, filter model.TaskListFilter) ([]model.TaskList, error) {
+	return f.lists, nil
+}
+
+func (f *fakeTaskLists) GetById(userId string, listId int) (model.TaskList, error) {
+	for _, list := range f.lists {
+		if list.Id == listId {
+			return list, nil
+		}
+	}
+	return model.TaskList{}, errors.New("task list not found")
+}
+
+func (f *fakeTaskLists) Create(userId string, list model.TaskList) (int, error) {
+	list.Id = len(f.lists) + 1
+	f.lists = append(f.lists, list)
+	return list.Id, nil
+}
+
+func (f *fakeTaskLists) Update(userId string, listId int, input model.UpdateTaskListInput) error {
+	f.updated = input
+	return nil
+}
+
+func (f *fakeTaskLists) Delete(userId string, listId int) error {
+	f.deleted = listId
+	return nil
+}
+
+func TestTaskctl(t *testing.T) {
+	lists := &fakeTaskLists{}
+	services := &service.Service{Authorization: fakeAuth{}, TaskList: lists}
+	server := httptest.NewServer(handlers.NewHandler(services, zap.NewNop(), handlers.Deprecation{}).InitRoutes(zap.NewNop()))
+	defer server.Close()
+
+	configPath := filepath.Join(t.TempDir(), "config.json")
+	taskctl := func(stdin string, args ...string) (string, error) {
+		var stdout, stderr bytes.Buffer
+		err := run(append([]string{"-config", configPath}, args...), strings.NewReader(stdin), &stdout, &stderr)
+		return stdout.String(), err
+	}
+
+	if _, err := taskctl("", "-server", server.URL, "list"); err == nil || !strings.Contains(err.Error(), "taskctl login") {
+		t.Fatalf("list before login error = %v, want a hint to log in", err)
+	}
+	if _, err := taskctl("secret\n", "-server", server.URL, "login", "-u", "alice"); err != nil {
+		t.Fatalf("login error = %v", err)
+	}
+	cfg, err := loadConfig(configPath)
+	if err != nil || cfg.Token != "token-1" || cfg.Server != server.URL {
+		t.Fatalf("stored config = %+v, %v", cfg, err)
+	}
+
+	// Later commands reuse the server of the login.
+	out, err := taskctl("", "create", "-title", "Release", "-priority", "high", "-due", "2026-11-01", "-tags", "work,q4")
+	if err != nil || out != "Created task list 1\n" {
+		t.Fatalf("create = %q, %v", out, err)
+	}
+	if due := lists.lists[0].DueDate; due == nil || !due.Equal(time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("created due date = %v", due)
+	}
+
+	out, err = taskctl("", "list")
+	if err != nil {
+		t.Fatalf("list error = %v", err)
+	}
+	wantTable := "ID  TITLE    STATUS  PRIORITY  DUE                   TAGS\n" +
+		"1   Release          high      2026-11-01T00:00:00Z  work,q4\n"
+	if out != wantTable {
+		t.Errorf("list table =\n%s\nwant\n%s", out, wantTable)
+	}
+
+	out, err = taskctl("", "-o", "json", "get", "1")
+	if err != nil {
+		t.Fatalf("get error = %v", err)
+	}
+	var got model.TaskList
+	if err := json.Unmarshal([]byte(out), &got); err != nil || got.Title != "Release" {
+		t.Errorf("get json = %q, %v", out, err)
+	}
+
+	if _, err := taskctl("", "update", "1", "-status", "done"); err != nil {
+		t.Fatalf("update error = %v", err)
+	}
+	if lists.updated.Status == nil || *lists.updated.Status != "done" || lists.updated.Title != nil {
+		t.Errorf("update input = %+v, want only the status", lists.updated)
+	}
+
+	if _, err := taskctl("", "delete", "1"); err != nil || lists.deleted != 1 {
+		t.Errorf("delete = %d, %v", lists.deleted, err)
+	}
+}
+
+func TestSaveConfig_RestrictsExistingFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(path, []byte("{}\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := saveConfig(path, config{Token: "token-1"}); err != nil {
+		t.Fatalf("saveConfig() error = %v", err)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mode := info.Mode().Perm(); mode != 0o600 {
+		t.Errorf("config mode = %o, want 600", mode)
+	}
+}
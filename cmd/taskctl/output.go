@@ -0,0 +1,64 @@
+package main
+
+import (
+	"TaskManager/pkg/client"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// Output formats.
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+)
+
+func writeJSON(w io.Writer, v interface{}) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+// writeTaskListTable prints one row per task list.
+func writeTaskListTable(w io.Writer, lists []client.TaskList) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "ID\tTITLE\tSTATUS\tPRIORITY\tDUE\tTAGS")
+	for _, list := range lists {
+		fmt.Fprintf(tw, "%d\t%s\t%s\t%s\t%s\t%s\n",
+			list.Id, list.Title, list.Status, list.Priority, formatTime(list.DueDate), strings.Join(list.Tags, ","))
+	}
+	return tw.Flush()
+}
+
+// writeTaskListDetails prints the fields of a task list followed by its items.
+func writeTaskListDetails(w io.Writer, list client.TaskList) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "ID:\t%d\n", list.Id)
+	fmt.Fprintf(tw, "Title:\t%s\n", list.Title)
+	fmt.Fprintf(tw, "Description:\t%s\n", list.Description)
+	fmt.Fprintf(tw, "Status:\t%s\n", list.Status)
+	fmt.Fprintf(tw, "Priority:\t%s\n", list.Priority)
+	fmt.Fprintf(tw, "Due:\t%s\n", formatTime(list.DueDate))
+	fmt.Fprintf(tw, "Tags:\t%s\n", strings.Join(list.Tags, ","))
+	if len(list.DependsOn) > 0 {
+		fmt.Fprintf(tw, "Depends on:\t%s\n", strings.Trim(fmt.Sprint(list.DependsOn), "[]"))
+	}
+	for _, item := range list.Items {
+		mark := "[ ]"
+		if item.Done {
+			mark = "[x]"
+		}
+		fmt.Fprintf(tw, "Item:\t%s %s\n", mark, item.Title)
+	}
+	return tw.Flush()
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return "-"
+	}
+	return t.Format(time.RFC3339)
+}
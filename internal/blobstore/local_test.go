@@ -0,0 +1,70 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestLocalStore_RoundTrip(t *testing.T) {
+	store, err := NewLocalStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewLocalStore() error = %v", err)
+	}
+	ctx := context.Background()
+	content := "attachment content"
+
+	if err := store.Put(ctx, "task-lists/1/a", strings.NewReader(content), int64(len(content)), "text/plain"); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+
+	r, err := store.Get(ctx, "task-lists/1/a")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	got, _ := io.ReadAll(r)
+	r.Close()
+	if string(got) != content {
+		t.Errorf("Get() = %q, want %q", got, content)
+	}
+
+	if err := store.Delete(ctx, "task-lists/1/a"); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if _, err := store.Get(ctx, "task-lists/1/a"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get() after Delete() error = %v, want ErrNotFound", err)
+	}
+	if err := store.Delete(ctx, "task-lists/1/a"); err != nil {
+		t.Errorf("Delete() of a missing blob error = %v", err)
+	}
+}
+
+func TestLocalStore_SizeMismatch(t *testing.T) {
+	store, err := NewLocalStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewLocalStore() error = %v", err)
+	}
+	ctx := context.Background()
+
+	if err := store.Put(ctx, "short", strings.NewReader("abc"), 10, "text/plain"); err == nil {
+		t.Fatal("Put() expected error for a truncated upload")
+	}
+	if _, err := store.Get(ctx, "short"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get() error = %v, a truncated upload must not be stored", err)
+	}
+}
+
+func TestLocalStore_InvalidKeys(t *testing.T) {
+	store, err := NewLocalStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewLocalStore() error = %v", err)
+	}
+
+	for _, key := range []string{"", "../escape", "a/../../escape", "."} {
+		if err := store.Put(context.Background(), key, strings.NewReader("x"), 1, "text/plain"); err == nil {
+			t.Errorf("Put(%q) expected error", key)
+		}
+	}
+}
@@ -4,11 +4,89 @@ import (
 	"flag"
 	"github.com/ilyakaznacheev/cleanenv"
 	"os"
+	"time"
 )
 
 type Config struct {
-	Port    string `yaml:"port" required:"true"`
-	MongoDb string `yaml:"MongoDb" required:"true"`
+	Port               string        `yaml:"port" required:"true"`
+	GRPCPort           string        `yaml:"grpc_port" env-default:":9090"`
+	MongoDb            string        `yaml:"MongoDb" required:"true"`
+	RecurrenceInterval time.Duration `yaml:"recurrence_interval" env-default:"1m"`
+	Notifications      Notifications `yaml:"notifications"`
+	WebhookInterval    time.Duration `yaml:"webhook_interval" env-default:"5s"`
+	Attachments        Attachments   `yaml:"attachments"`
+	LegacyAPI          LegacyAPI     `yaml:"legacy_api"`
+	JWT                JWT           `yaml:"jwt"`
+	OIDC               OIDC          `yaml:"oidc"`
+}
+
+// OIDC configures sign-in through an OpenID Connect provider. It is enabled when the issuer is set.
+type OIDC struct {
+	Issuer       string   `yaml:"issuer"`
+	ClientID     string   `yaml:"client_id"`
+	ClientSecret string   `yaml:"client_secret" env:"OIDC_CLIENT_SECRET"`
+	RedirectURL  string   `yaml:"redirect_url"`
+	Scopes       []string `yaml:"scopes" env-default:"openid,profile,email"`
+	CreateUsers  bool     `yaml:"create_users" env-default:"true"`
+}
+
+// JWT configures how access tokens are signed. Either the HS256 secret or at least one key must be set.
+// During a rotation the new key is added and made the signing key, while the old one stays listed,
+// possibly with only its public key, until the tokens it signed have expired.
+type JWT struct {
+	Secret     string        `yaml:"secret" env:"SIGNING_KEY"`
+	SigningKey string        `yaml:"signing_key"`
+	Keys       []JWTKey      `yaml:"keys"`
+	TTL        time.Duration `yaml:"ttl" env-default:"12h"`
+}
+
+// JWTKey is an RSA (RS256) or Ed25519 (EdDSA) key in PEM files, identified in tokens by its ID.
+type JWTKey struct {
+	Id             string `yaml:"id"`
+	PrivateKeyFile string `yaml:"private_key_file"`
+	PublicKeyFile  string `yaml:"public_key_file"`
+}
+
+// LegacyAPI announces the retirement of the unversioned routes that predate /api/v1.
+type LegacyAPI struct {
+	DeprecatedAt time.Time `yaml:"deprecated_at" env-default:"2026-10-19T00:00:00Z"`
+	SunsetAt     time.Time `yaml:"sunset_at" env-default:"2027-04-19T00:00:00Z"`
+}
+
+// Notifications configures the reminder worker and the channels it delivers through.
+// A channel is enabled when its address is set.
+type Notifications struct {
+	Interval   time.Duration `yaml:"interval" env-default:"1m"`
+	WebhookURL string        `yaml:"webhook_url"`
+	SMTP       SMTP          `yaml:"smtp"`
+}
+
+// SMTP configures the email notifier.
+type SMTP struct {
+	Host     string `yaml:"host"`
+	Port     int    `yaml:"port" env-default:"25"`
+	Username string `yaml:"username"`
+	Password string `yaml:"password" env:"SMTP_PASSWORD"`
+	From     string `yaml:"from" env-default:"taskmanager@localhost"`
+}
+
+// Attachments configures the limits of uploaded files and the blob store they are kept in.
+// Store is "local" for the Dir directory or "s3" for an S3-compatible bucket.
+type Attachments struct {
+	MaxSize      int64    `yaml:"max_size" env-default:"10485760"`
+	AllowedTypes []string `yaml:"allowed_types" env-default:"image/png,image/jpeg,image/gif,image/webp,application/pdf,text/plain"`
+	Store        string   `yaml:"store" env-default:"local"`
+	Dir          string   `yaml:"dir" env-default:"data/attachments"`
+	S3           S3       `yaml:"s3"`
+}
+
+// S3 configures an S3-compatible bucket addressed with path-style URLs.
+type S3 struct {
+	Endpoint  string `yaml:"endpoint"`
+	Region    string `yaml:"region" env-default:"us-east-1"`
+	Bucket    string `yaml:"bucket"`
+	AccessKey string `yaml:"access_key" env:"S3_ACCESS_KEY"`
+	SecretKey string `yaml:"secret_key" env:"S3_SECRET_KEY"`
 }
 
 // MustLoad loads the configuration from the default path.
@@ -0,0 +1,46 @@
+package model
+
+import (
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"time"
+)
+
+// AccessTokenPrefix starts every personal access token, which tells them apart from JWTs.
+const AccessTokenPrefix = "tmpat_"
+
+// Token scopes.
+const (
+	ScopeTasksRead    = "tasks:read"
+	ScopeTasksWrite   = "tasks:write"
+	ScopeAccountAdmin = "account:admin"
+)
+
+// Scopes lists every scope a token can be granted.
+var Scopes = []string{ScopeTasksRead, ScopeTasksWrite, ScopeAccountAdmin}
+
+// AccessToken is a personal access token that scripts use instead of the user's password.
+// Only the SHA-256 hash of the token is stored; the token itself is shown once, when it is created.
+type AccessToken struct {
+	Id         bson.ObjectID `json:"id" bson:"_id,omitempty"`
+	UserId     string        `json:"user_id" bson:"user_id"`
+	Name       string        `json:"name" bson:"name"`
+	Scopes     []string      `json:"scopes" bson:"scopes"`
+	Hash       string        `json:"-" bson:"hash"`
+	ExpiresAt  time.Time     `json:"expires_at" bson:"expires_at"`
+	LastUsedAt *time.Time    `json:"last_used_at,omitempty" bson:"last_used_at,omitempty"`
+	RevokedAt  *time.Time    `json:"revoked_at,omitempty" bson:"revoked_at,omitempty"`
+	CreatedAt  time.Time     `json:"created_at" bson:"created_at"`
+}
+
+// AccessTokenInput is used to create a personal access token. Without ExpiresAt the token expires after 90 days.
+type AccessTokenInput struct {
+	Name      string     `json:"name"`
+	Scopes    []string   `json:"scopes"`
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+// CreatedAccessToken is a new personal access token together with the token value.
+type CreatedAccessToken struct {
+	AccessToken
+	Token string `json:"token"`
+}
@@ -0,0 +1,18 @@
+package model
+
+import (
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"time"
+)
+
+// Attachment describes a file uploaded to a task list. Its content is kept in the blob store under Key.
+type Attachment struct {
+	Id          bson.ObjectID `json:"id" bson:"_id,omitempty"`
+	ListId      int           `json:"list_id" bson:"list_id"`
+	UserId      string        `json:"user_id" bson:"user_id"`
+	Filename    string        `json:"filename" bson:"filename"`
+	ContentType string        `json:"content_type" bson:"content_type"`
+	Size        int64         `json:"size" bson:"size"`
+	Key         string        `json:"-" bson:"key"`
+	CreatedAt   time.Time     `json:"created_at" bson:"created_at"`
+}
@@ -0,0 +1,57 @@
+package model
+
+import (
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"time"
+)
+
+// Board is a kanban board whose ordered columns hold task lists.
+// A board belongs to a workspace when WorkspaceId is set, otherwise to the user who created it.
+type Board struct {
+	Id          bson.ObjectID `json:"id" bson:"_id,omitempty"`
+	UserId      string        `json:"user_id" bson:"user_id"`
+	WorkspaceId string        `json:"workspace_id,omitempty" bson:"workspace_id,omitempty"`
+	Name        string        `json:"name" bson:"name"`
+	Columns     []BoardColumn `json:"columns" bson:"columns"`
+	CreatedAt   time.Time     `json:"created_at" bson:"created_at"`
+}
+
+// BoardColumn is a column of a board.
+type BoardColumn struct {
+	Id   string `json:"id" bson:"id"`
+	Name string `json:"name" bson:"name"`
+}
+
+// BoardInput is used to create a board with its initial columns.
+type BoardInput struct {
+	Name        string   `json:"name"`
+	WorkspaceId string   `json:"workspace_id"`
+	Columns     []string `json:"columns"`
+}
+
+// BoardColumnInput is used to add a column to a board.
+type BoardColumnInput struct {
+	Name string `json:"name"`
+}
+
+// MoveTaskListInput places a task list in a board column between two neighbours.
+// AfterId and BeforeId are the IDs of the lists that should precede and follow it;
+// when both are zero the list is appended to the end of the column.
+type MoveTaskListInput struct {
+	BoardId  string `json:"board_id"`
+	ColumnId string `json:"column_id"`
+	AfterId  int    `json:"after_id"`
+	BeforeId int    `json:"before_id"`
+}
+
+// BoardView is a board together with the task lists of each column in order.
+type BoardView struct {
+	Board
+	Columns []BoardColumnView `json:"columns"`
+}
+
+// BoardColumnView is a board column together with its task lists in order.
+type BoardColumnView struct {
+	BoardColumn
+	Lists []TaskList `json:"lists"`
+}
@@ -0,0 +1,47 @@
+package model
+
+import (
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"time"
+)
+
+// Comment is a message in the discussion of a task list. Replies reference the top-level comment of their thread.
+type Comment struct {
+	Id       bson.ObjectID `json:"id" bson:"_id,omitempty"`
+	ListId   int           `json:"list_id" bson:"list_id"`
+	UserId   string        `json:"user_id" bson:"user_id"`
+	ParentId string        `json:"parent_id,omitempty" bson:"parent_id,omitempty"`
+	Body     string        `json:"body" bson:"body"`
+	// Mentions holds the IDs of the users mentioned in the body.
+	Mentions  []string   `json:"mentions,omitempty" bson:"mentions,omitempty"`
+	CreatedAt time.Time  `json:"created_at" bson:"created_at"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty" bson:"updated_at,omitempty"`
+}
+
+// CommentThread is a top-level comment together with its replies in order.
+type CommentThread struct {
+	Comment
+	Replies []Comment `json:"replies"`
+}
+
+// CommentInput is used to post or edit a comment. ParentId makes the comment a reply.
+type CommentInput struct {
+	Body     string `json:"body"`
+	ParentId string `json:"parent_id"`
+}
+
+// Mention records that a user was mentioned in a comment.
+type Mention struct {
+	Id        bson.ObjectID `json:"id" bson:"_id,omitempty"`
+	UserId    string        `json:"user_id" bson:"user_id"`
+	AuthorId  string        `json:"author_id" bson:"author_id"`
+	ListId    int           `json:"list_id" bson:"list_id"`
+	CommentId string        `json:"comment_id" bson:"comment_id"`
+	CreatedAt time.Time     `json:"created_at" bson:"created_at"`
+}
+
+// Page selects a window of a result set.
+type Page struct {
+	Offset int
+	Limit  int
+}
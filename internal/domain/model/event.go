@@ -0,0 +1,27 @@
+package model
+
+import "time"
+
+// Task list event types.
+const (
+	EventTaskListCreated = "task_list.created"
+	EventTaskListUpdated = "task_list.updated"
+	EventTaskListDeleted = "task_list.deleted"
+)
+
+// EventTypes lists every event type that can be emitted.
+var EventTypes = []string{
+	EventTaskListCreated,
+	EventTaskListUpdated,
+	EventTaskListDeleted,
+}
+
+// Event describes a change to a task list, delivered to UserId. A change is published once for every user
+// who can see the list. Id is assigned in publication order and is used to resume event streams.
+type Event struct {
+	Id         int64     `json:"id"`
+	Type       string    `json:"type"`
+	UserId     string    `json:"user_id"`
+	TaskList   TaskList  `json:"task_list"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
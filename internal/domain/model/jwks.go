@@ -0,0 +1,18 @@
+package model
+
+// JSONWebKeySet is the set of public keys that verify the tokens issued by the server (RFC 7517).
+type JSONWebKeySet struct {
+	Keys []JSONWebKey `json:"keys"`
+}
+
+// JSONWebKey is a public key in JWK format. RSA keys set N and E, Ed25519 keys set Crv and X.
+type JSONWebKey struct {
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
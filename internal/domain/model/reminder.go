@@ -0,0 +1,26 @@
+package model
+
+import (
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"time"
+)
+
+// Reminder delivery statuses.
+const (
+	DeliverySent   = "sent"
+	DeliveryFailed = "failed"
+)
+
+// ReminderDelivery records the attempts to deliver one reminder of a task list through one notifier.
+type ReminderDelivery struct {
+	Id        bson.ObjectID `json:"id" bson:"_id,omitempty"`
+	UserId    string        `json:"user_id" bson:"user_id"`
+	ListId    int           `json:"list_id" bson:"list_id"`
+	DueDate   time.Time     `json:"due_date" bson:"due_date"`
+	Offset    int           `json:"offset" bson:"offset"`
+	Channel   string        `json:"channel" bson:"channel"`
+	Status    string        `json:"status" bson:"status"`
+	Attempts  int           `json:"attempts" bson:"attempts"`
+	Error     string        `json:"error,omitempty" bson:"error,omitempty"`
+	UpdatedAt time.Time     `json:"updated_at" bson:"updated_at"`
+}
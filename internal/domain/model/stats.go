@@ -0,0 +1,20 @@
+package model
+
+// Stats summarizes the task lists a user can access.
+type Stats struct {
+	ByStatus   map[string]int `json:"by_status"`
+	ByPriority map[string]int `json:"by_priority"`
+	// Overdue are the open task lists whose due date has passed, the oldest due first.
+	Overdue []TaskList    `json:"overdue"`
+	Weekly  []WeeklyStats `json:"weekly"`
+	// AverageCompletionSeconds is the mean time from creation to completion of the done task lists,
+	// or nil when none of them has both times recorded.
+	AverageCompletionSeconds *int64 `json:"average_completion_seconds"`
+}
+
+// WeeklyStats counts the task lists created and completed during an ISO week, such as 2024-W05.
+type WeeklyStats struct {
+	Week      string `json:"week"`
+	Created   int    `json:"created"`
+	Completed int    `json:"completed"`
+}
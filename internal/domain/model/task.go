@@ -1,15 +1,111 @@
 package model
 
+import "time"
+
+// Task list statuses.
+const (
+	StatusTodo = "todo"
+	StatusDone = "done"
+)
+
+// Task list priorities. Lists stored without a priority count as medium.
+const (
+	PriorityLow    = "low"
+	PriorityMedium = "medium"
+	PriorityHigh   = "high"
+)
+
 // TaskList represents a task in the task management system.
 type TaskList struct {
-	Id          int    `json:"id" bson:"id"`
-	UserId      string `json:"user_id" bson:"user_id"`
-	Title       string `json:"title" binding:"required" bson:"title"`
-	Description string `json:"description" bson:"description"`
+	Id          int         `json:"id" bson:"id"`
+	UserId      string      `json:"user_id" bson:"user_id"`
+	WorkspaceId string      `json:"workspace_id,omitempty" bson:"workspace_id,omitempty"`
+	Title       string      `json:"title" binding:"required" bson:"title"`
+	Description string      `json:"description" bson:"description"`
+	Status      string      `json:"status" bson:"status"`
+	Priority    string      `json:"priority" bson:"priority"`
+	DueDate     *time.Time  `json:"due_date,omitempty" bson:"due_date,omitempty"`
+	CompletedAt *time.Time  `json:"completed_at,omitempty" bson:"completed_at,omitempty"`
+	CreatedAt   *time.Time  `json:"created_at,omitempty" bson:"created_at,omitempty"`
+	Recurrence  *Recurrence `json:"recurrence,omitempty" bson:"recurrence,omitempty"`
+	Tags        []string    `json:"tags,omitempty" bson:"tags,omitempty"`
+	BoardId     string      `json:"board_id,omitempty" bson:"board_id,omitempty"`
+	ColumnId    string      `json:"column_id,omitempty" bson:"column_id,omitempty"`
+	// Position is a fractional index ordering the list within its board column.
+	Position string `json:"position,omitempty" bson:"position,omitempty"`
+	// ReminderOffsets are minutes before the due date at which the owner is reminded.
+	ReminderOffsets []int `json:"reminder_offsets,omitempty" bson:"reminder_offsets,omitempty"`
+	// DependsOn holds the IDs of the task lists that block this one until they are done.
+	DependsOn []int `json:"depends_on,omitempty" bson:"depends_on,omitempty"`
+	// Items are the subtasks of the list.
+	Items []TaskItem `json:"items,omitempty" bson:"items,omitempty"`
 }
 
-// UpdateTaskListInput is used to update a task list's title and description.
+// TaskItem is a subtask of a task list. It can be blocked by other items of the same list.
+type TaskItem struct {
+	Id        string   `json:"id" bson:"id"`
+	Title     string   `json:"title" bson:"title"`
+	Done      bool     `json:"done" bson:"done"`
+	DependsOn []string `json:"depends_on,omitempty" bson:"depends_on,omitempty"`
+}
+
+// Recurrence describes how a task list repeats using an iCalendar RRULE.
+type Recurrence struct {
+	RRule    string    `json:"rrule" bson:"rrule"`
+	Start    time.Time `json:"start" bson:"start"`
+	SeriesId int       `json:"series_id" bson:"series_id"`
+	NextId   int       `json:"next_id,omitempty" bson:"next_id"`
+}
+
+// UpdateTaskListInput is used to update a task list's title, description, status, priority, due date, reminders and workspace.
 type UpdateTaskListInput struct {
-	Title       *string `json:"title" bson:"title"`
-	Description *string `json:"description" bson:"description"`
+	Title           *string    `json:"title" bson:"title"`
+	Description     *string    `json:"description" bson:"description"`
+	Status          *string    `json:"status" bson:"status"`
+	Priority        *string    `json:"priority" bson:"priority"`
+	DueDate         *time.Time `json:"due_date" bson:"due_date"`
+	ReminderOffsets *[]int     `json:"reminder_offsets" bson:"reminder_offsets"`
+	// WorkspaceId moves the list into a workspace, or back to its owner when empty.
+	WorkspaceId *string `json:"workspace_id" bson:"workspace_id"`
+}
+
+// RecurrenceInput is used to set or edit the recurrence rule of a task list series.
+type RecurrenceInput struct {
+	RRule string `json:"rrule"`
+}
+
+// TaskListFilter narrows the task lists returned by GetAll.
+type TaskListFilter struct {
+	Tags []string
+	// MatchAllTags requires every tag instead of any of them.
+	MatchAllTags bool
+}
+
+// TagsInput is used to add tags to a task list.
+type TagsInput struct {
+	Tags []string `json:"tags"`
+}
+
+// TagCount is a tag together with the number of the user's task lists that carry it.
+type TagCount struct {
+	Tag   string `json:"tag" bson:"_id"`
+	Count int    `json:"count" bson:"count"`
+}
+
+// DependencyInput is used to make a task list depend on another one.
+type DependencyInput struct {
+	DependsOn int `json:"depends_on"`
+}
+
+// TaskItemInput is used to add a subtask to a task list.
+type TaskItemInput struct {
+	Title     string   `json:"title"`
+	DependsOn []string `json:"depends_on"`
+}
+
+// UpdateTaskItemInput is used to update a subtask's title, state and dependencies.
+type UpdateTaskItemInput struct {
+	Title     *string   `json:"title"`
+	Done      *bool     `json:"done"`
+	DependsOn *[]string `json:"depends_on"`
 }
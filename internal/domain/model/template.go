@@ -0,0 +1,38 @@
+package model
+
+import (
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"time"
+)
+
+// Template is a saved task list with its items and tags that users instantiate repeatedly.
+// Titles and descriptions may contain variables such as {{date}}.
+type Template struct {
+	Id          bson.ObjectID `json:"id" bson:"_id,omitempty"`
+	UserId      string        `json:"user_id" bson:"user_id"`
+	Name        string        `json:"name" bson:"name"`
+	Title       string        `json:"title" bson:"title"`
+	Description string        `json:"description" bson:"description"`
+	Tags        []string      `json:"tags,omitempty" bson:"tags,omitempty"`
+	// Items are the titles of the subtasks created with each list.
+	Items     []string  `json:"items,omitempty" bson:"items,omitempty"`
+	CreatedAt time.Time `json:"created_at" bson:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" bson:"updated_at"`
+}
+
+// TemplateInput is used to create or replace a template.
+type TemplateInput struct {
+	Name        string   `json:"name"`
+	Title       string   `json:"title"`
+	Description string   `json:"description"`
+	Tags        []string `json:"tags"`
+	Items       []string `json:"items"`
+}
+
+// InstantiateTemplateInput is used to create a task list from a template.
+// Variables supply or override the values substituted into the template.
+type InstantiateTemplateInput struct {
+	Variables   map[string]string `json:"variables"`
+	DueDate     *time.Time        `json:"due_date"`
+	WorkspaceId string            `json:"workspace_id"`
+}
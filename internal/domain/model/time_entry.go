@@ -0,0 +1,50 @@
+package model
+
+import (
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"time"
+)
+
+// TimeEntry is time a user spent on a task list, either tracked with a timer or entered by hand.
+// A running timer has no End yet.
+type TimeEntry struct {
+	Id      bson.ObjectID `json:"id" bson:"_id,omitempty"`
+	UserId  string        `json:"user_id" bson:"user_id"`
+	ListId  int           `json:"list_id" bson:"list_id"`
+	Start   time.Time     `json:"start" bson:"start"`
+	End     *time.Time    `json:"end,omitempty" bson:"end,omitempty"`
+	Running bool          `json:"running" bson:"running"`
+	// Seconds is the tracked duration, set once the entry has ended.
+	Seconds   int64     `json:"seconds" bson:"seconds"`
+	Note      string    `json:"note,omitempty" bson:"note,omitempty"`
+	CreatedAt time.Time `json:"created_at" bson:"created_at"`
+}
+
+// TimerInput is used to start a timer with an optional note.
+type TimerInput struct {
+	Note string `json:"note"`
+}
+
+// TimeEntryInput is used to record time spent on a task list by hand.
+type TimeEntryInput struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+	Note  string    `json:"note"`
+}
+
+// TimeReport aggregates a user's tracked time over a date range. To is exclusive.
+type TimeReport struct {
+	From         time.Time   `json:"from"`
+	To           time.Time   `json:"to"`
+	TotalSeconds int64       `json:"total_seconds"`
+	ByList       []TimeTotal `json:"by_list"`
+	ByTag        []TimeTotal `json:"by_tag"`
+	ByDay        []TimeTotal `json:"by_day"`
+}
+
+// TimeTotal is the time tracked for one list, tag or day of a report.
+type TimeTotal struct {
+	Key     string `json:"key"`
+	Label   string `json:"label,omitempty"`
+	Seconds int64  `json:"seconds"`
+}
@@ -0,0 +1,39 @@
+package model
+
+// TwoFactor is the TOTP second factor of a user. It is enabled once the user confirms
+// enrollment with a code from their authenticator app.
+type TwoFactor struct {
+	// Secret is the base32-encoded TOTP secret shared with the authenticator app.
+	Secret  string `bson:"secret"`
+	Enabled bool   `bson:"enabled"`
+	// RecoveryCodes are the SHA-256 hashes of the unused recovery codes.
+	RecoveryCodes []string `bson:"recovery_codes,omitempty"`
+	// LastStep is the time step of the last accepted code, so a code cannot be used twice.
+	LastStep int64 `bson:"last_step,omitempty"`
+	// Failures counts the invalid codes entered since the last accepted one.
+	Failures int `bson:"failures,omitempty"`
+}
+
+// TwoFactorEnrollment is returned when a user starts enrolling, to be entered in or scanned by an authenticator app.
+type TwoFactorEnrollment struct {
+	Secret string `json:"secret"`
+	URI    string `json:"otpauth_uri"`
+}
+
+// TwoFactorCodeInput carries a code from the authenticator app or a recovery code.
+type TwoFactorCodeInput struct {
+	Code string `json:"code"`
+}
+
+// TwoFactorLoginInput completes a login of a user with two-factor authentication.
+type TwoFactorLoginInput struct {
+	Challenge string `json:"challenge"`
+	Code      string `json:"code"`
+}
+
+// DisableTwoFactorInput re-authenticates the user before two-factor authentication is turned off.
+// Users who sign in through an OIDC provider leave Password empty.
+type DisableTwoFactorInput struct {
+	Password string `json:"password"`
+	Code     string `json:"code"`
+}
@@ -6,4 +6,22 @@ type User struct {
 	Id       bson.ObjectID `json:"id" bson:"_id,omitempty"`
 	Username string        `bson:"username" json:"username"`
 	Password string        `bson:"password" json:"password"`
+	Email    string        `bson:"email,omitempty" json:"email,omitempty"`
+	// Disabled accounts cannot log in.
+	Disabled bool `bson:"disabled,omitempty" json:"-"`
+	// Identities are the accounts at external identity providers the user signs in with.
+	Identities []ExternalIdentity `bson:"identities,omitempty" json:"-"`
+	// TwoFactor is set once the user starts enrolling an authenticator app.
+	TwoFactor *TwoFactor `bson:"two_factor,omitempty" json:"-"`
+}
+
+// TwoFactorEnabled reports whether logins of the user need a second factor.
+func (u User) TwoFactorEnabled() bool {
+	return u.TwoFactor != nil && u.TwoFactor.Enabled
+}
+
+// ExternalIdentity is an account at an OpenID Connect provider, identified by the issuer and its subject claim.
+type ExternalIdentity struct {
+	Issuer  string `bson:"issuer" json:"issuer"`
+	Subject string `bson:"subject" json:"subject"`
 }
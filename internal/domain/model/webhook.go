@@ -0,0 +1,39 @@
+package model
+
+import (
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"time"
+)
+
+// Webhook delivery statuses.
+const (
+	WebhookDeliveryPending   = "pending"
+	WebhookDeliverySucceeded = "succeeded"
+	WebhookDeliveryFailed    = "failed"
+)
+
+// Webhook is a user's subscription to task list events delivered to an external URL.
+type Webhook struct {
+	Id        bson.ObjectID `json:"id" bson:"_id,omitempty"`
+	UserId    string        `json:"user_id" bson:"user_id"`
+	URL       string        `json:"url" bson:"url"`
+	Secret    string        `json:"secret,omitempty" bson:"secret"`
+	Events    []string      `json:"events" bson:"events"`
+	CreatedAt time.Time     `json:"created_at" bson:"created_at"`
+}
+
+// WebhookDelivery records the delivery of one event to one webhook, including its retries.
+type WebhookDelivery struct {
+	Id            bson.ObjectID `json:"id" bson:"_id,omitempty"`
+	WebhookId     string        `json:"webhook_id" bson:"webhook_id"`
+	UserId        string        `json:"user_id" bson:"user_id"`
+	Event         string        `json:"event" bson:"event"`
+	Payload       string        `json:"payload" bson:"payload"`
+	Status        string        `json:"status" bson:"status"`
+	Attempts      int           `json:"attempts" bson:"attempts"`
+	ResponseCode  int           `json:"response_code,omitempty" bson:"response_code,omitempty"`
+	Error         string        `json:"error,omitempty" bson:"error,omitempty"`
+	NextAttemptAt time.Time     `json:"next_attempt_at" bson:"next_attempt_at"`
+	CreatedAt     time.Time     `json:"created_at" bson:"created_at"`
+	UpdatedAt     time.Time     `json:"updated_at" bson:"updated_at"`
+}
@@ -0,0 +1,49 @@
+package model
+
+import (
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"time"
+)
+
+// Workspace member roles, from most to least privileged.
+const (
+	RoleOwner  = "owner"
+	RoleAdmin  = "admin"
+	RoleMember = "member"
+)
+
+// Workspace groups task lists shared by a team of users.
+type Workspace struct {
+	Id        bson.ObjectID     `json:"id" bson:"_id,omitempty"`
+	Name      string            `json:"name" bson:"name"`
+	OwnerId   string            `json:"owner_id" bson:"owner_id"`
+	Members   []WorkspaceMember `json:"members" bson:"members"`
+	CreatedAt time.Time         `json:"created_at" bson:"created_at"`
+}
+
+// WorkspaceMember is a user's membership in a workspace.
+type WorkspaceMember struct {
+	UserId string `json:"user_id" bson:"user_id"`
+	Role   string `json:"role" bson:"role"`
+}
+
+// WorkspaceInput is used to create or rename a workspace.
+type WorkspaceInput struct {
+	Name string `json:"name"`
+}
+
+// WorkspaceMemberInput is used to add a member to a workspace or change their role.
+type WorkspaceMemberInput struct {
+	Username string `json:"username"`
+	Role     string `json:"role"`
+}
+
+// Role returns the role of the user in the workspace, or an empty string when they are not a member.
+func (w Workspace) Role(userId string) string {
+	for _, member := range w.Members {
+		if member.UserId == userId {
+			return member.Role
+		}
+	}
+	return ""
+}
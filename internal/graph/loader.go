@@ -0,0 +1,83 @@
+package graph
+
+import "sync"
+
+// loader batches the loading of values by key in the style of a dataloader.
+// Resolvers queue the keys of a whole result level with Prime as soon as it is known,
+// and the first Load fetches every queued key in a single call instead of one call per parent.
+type loader[K comparable, V any] struct {
+	fetch func(keys []K) (map[K]V, error)
+
+	mu     sync.Mutex
+	queued []K
+	values map[K]V
+	// fetched records the keys already requested, including those without a value.
+	fetched map[K]bool
+}
+
+// newLoader creates a loader that fetches queued keys with fetch. Keys missing from its result have no value.
+func newLoader[K comparable, V any](fetch func(keys []K) (map[K]V, error)) *loader[K, V] {
+	return &loader[K, V]{
+		fetch:   fetch,
+		values:  map[K]V{},
+		fetched: map[K]bool{},
+	}
+}
+
+// Prime queues keys to be fetched by the next Load.
+func (l *loader[K, V]) Prime(keys ...K) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.queue(keys)
+}
+
+// Load returns the value of a key, fetching it together with every queued key when it is not loaded yet.
+// The boolean reports whether the key has a value.
+func (l *loader[K, V]) Load(key K) (V, bool, error) {
+	values, err := l.LoadMany([]K{key})
+	if err != nil || len(values) == 0 {
+		var zero V
+		return zero, false, err
+	}
+	return values[0], true, nil
+}
+
+// LoadMany returns the values of the keys that have one, in order.
+func (l *loader[K, V]) LoadMany(keys []K) ([]V, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.queue(keys)
+	if len(l.queued) > 0 {
+		batch := l.queued
+		l.queued = nil
+		values, err := l.fetch(batch)
+		if err != nil {
+			for _, key := range batch {
+				delete(l.fetched, key)
+			}
+			return nil, err
+		}
+		for key, value := range values {
+			l.values[key] = value
+		}
+	}
+
+	result := make([]V, 0, len(keys))
+	for _, key := range keys {
+		if value, ok := l.values[key]; ok {
+			result = append(result, value)
+		}
+	}
+	return result, nil
+}
+
+// queue adds the keys that were not requested before to the next batch. The caller must hold mu.
+func (l *loader[K, V]) queue(keys []K) {
+	for _, key := range keys {
+		if !l.fetched[key] {
+			l.fetched[key] = true
+			l.queued = append(l.queued, key)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package graph
+
+import (
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestLoader_BatchesPrimedKeys(t *testing.T) {
+	var batches [][]int
+	l := newLoader(func(keys []int) (map[int]string, error) {
+		batches = append(batches, keys)
+		values := map[int]string{}
+		for _, key := range keys {
+			if key != 3 {
+				values[key] = string(rune('a' + key))
+			}
+		}
+		return values, nil
+	})
+
+	l.Prime(1, 2, 3, 2)
+	var wg sync.WaitGroup
+	for _, key := range []int{1, 2, 3} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			value, ok, err := l.Load(key)
+			if err != nil || ok != (key != 3) || (ok && value != string(rune('a'+key))) {
+				t.Errorf("Load(%d) = %q, %v, %v", key, value, ok, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	values, err := l.LoadMany([]int{2, 3, 4})
+	if err != nil {
+		t.Fatalf("LoadMany() error = %v", err)
+	}
+	if !reflect.DeepEqual(values, []string{"c", "e"}) {
+		t.Errorf("LoadMany() = %v, want [c e]", values)
+	}
+
+	// Keys are fetched once, including the one without a value.
+	if want := [][]int{{1, 2, 3}, {4}}; !reflect.DeepEqual(batches, want) {
+		t.Errorf("batches = %v, want %v", batches, want)
+	}
+}
+
+func TestLoader_RetriesFailedBatch(t *testing.T) {
+	calls := 0
+	l := newLoader(func(keys []string) (map[string]int, error) {
+		calls++
+		if calls == 1 {
+			return nil, errors.New("unavailable")
+		}
+		return map[string]int{"a": 1}, nil
+	})
+
+	if _, _, err := l.Load("a"); err == nil {
+		t.Fatal("Load() expected the fetch error")
+	}
+	if value, ok, err := l.Load("a"); err != nil || !ok || value != 1 {
+		t.Errorf("Load() after a failure = %d, %v, %v, want 1, true, nil", value, ok, err)
+	}
+}
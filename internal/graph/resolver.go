@@ -0,0 +1,292 @@
+package graph
+
+import (
+	"TaskManager/internal/domain/model"
+	"TaskManager/internal/service"
+	"context"
+	"errors"
+	"github.com/graph-gophers/graphql-go"
+	"time"
+)
+
+// resolver resolves the queries and mutations of the schema.
+type resolver struct {
+	services *service.Service
+}
+
+// Me resolves the authenticated user.
+func (r *resolver) Me(ctx context.Context) (*userResolver, error) {
+	state := stateFrom(ctx)
+	user, ok, err := state.users.Load(state.userId)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errors.New("user not found")
+	}
+	return &userResolver{user: user}, nil
+}
+
+// TaskLists resolves the task lists the user can access.
+func (r *resolver) TaskLists(ctx context.Context, args struct {
+	Tags         *[]string
+	MatchAllTags *bool
+}) ([]*taskListResolver, error) {
+	state := stateFrom(ctx)
+	var filter model.TaskListFilter
+	if args.Tags != nil {
+		filter.Tags = *args.Tags
+	}
+	if args.MatchAllTags != nil {
+		filter.MatchAllTags = *args.MatchAllTags
+	}
+
+	lists, err := r.services.TaskList.GetAll(state.userId, filter)
+	if err != nil {
+		return nil, err
+	}
+	return newTaskListResolvers(state, lists), nil
+}
+
+// TaskList resolves a single task list, or null when the user cannot access it.
+func (r *resolver) TaskList(ctx context.Context, args struct{ Id int32 }) (*taskListResolver, error) {
+	state := stateFrom(ctx)
+	list, ok, err := state.lists.Load(int(args.Id))
+	if err != nil || !ok {
+		return nil, err
+	}
+	return newTaskListResolvers(state, []model.TaskList{list})[0], nil
+}
+
+// Tags resolves the user's tags.
+func (r *resolver) Tags(ctx context.Context) ([]*tagResolver, error) {
+	tags, err := r.services.TaskList.GetTags(stateFrom(ctx).userId)
+	if err != nil {
+		return nil, err
+	}
+	resolvers := make([]*tagResolver, 0, len(tags))
+	for _, tag := range tags {
+		resolvers = append(resolvers, &tagResolver{tag: tag})
+	}
+	return resolvers, nil
+}
+
+// CreateTaskList creates a task list and resolves it.
+func (r *resolver) CreateTaskList(ctx context.Context, args struct {
+	Input struct {
+		Title       string
+		Description *string
+		Priority    *string
+		DueDate     *graphql.Time
+		Tags        *[]string
+		WorkspaceId *graphql.ID
+		DependsOn   *[]int32
+		Items       *[]string
+	}
+}) (*taskListResolver, error) {
+	state := stateFrom(ctx)
+	if err := state.requireScope(model.ScopeTasksWrite); err != nil {
+		return nil, err
+	}
+	input := args.Input
+	list := model.TaskList{
+		Title:       input.Title,
+		Description: stringValue(input.Description),
+		Priority:    stringValue(input.Priority),
+		DueDate:     timeValue(input.DueDate),
+	}
+	if input.Tags != nil {
+		list.Tags = *input.Tags
+	}
+	if input.WorkspaceId != nil {
+		list.WorkspaceId = string(*input.WorkspaceId)
+	}
+	if input.DependsOn != nil {
+		for _, id := range *input.DependsOn {
+			list.DependsOn = append(list.DependsOn, int(id))
+		}
+	}
+	if input.Items != nil {
+		for _, title := range *input.Items {
+			list.Items = append(list.Items, model.TaskItem{Title: title})
+		}
+	}
+
+	id, err := r.services.TaskList.Create(state.userId, list)
+	if err != nil {
+		return nil, err
+	}
+	return r.taskList(state, id)
+}
+
+// UpdateTaskList updates the fields set in the input and resolves the task list.
+func (r *resolver) UpdateTaskList(ctx context.Context, args struct {
+	Id    int32
+	Input struct {
+		Title       *string
+		Description *string
+		Status      *string
+		Priority    *string
+		DueDate     *graphql.Time
+		WorkspaceId *graphql.ID
+	}
+}) (*taskListResolver, error) {
+	state := stateFrom(ctx)
+	if err := state.requireScope(model.ScopeTasksWrite); err != nil {
+		return nil, err
+	}
+	input := model.UpdateTaskListInput{
+		Title:       args.Input.Title,
+		Description: args.Input.Description,
+		Status:      args.Input.Status,
+		Priority:    args.Input.Priority,
+		DueDate:     timeValue(args.Input.DueDate),
+	}
+	if args.Input.WorkspaceId != nil {
+		workspaceId := string(*args.Input.WorkspaceId)
+		input.WorkspaceId = &workspaceId
+	}
+
+	if err := r.services.TaskList.Update(state.userId, int(args.Id), input); err != nil {
+		return nil, err
+	}
+	return r.taskList(state, int(args.Id))
+}
+
+// DeleteTaskList deletes a task list.
+func (r *resolver) DeleteTaskList(ctx context.Context, args struct{ Id int32 }) (bool, error) {
+	state := stateFrom(ctx)
+	if err := state.requireScope(model.ScopeTasksWrite); err != nil {
+		return false, err
+	}
+	if err := r.services.TaskList.Delete(state.userId, int(args.Id)); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// AddTags adds tags to a task list and resolves it.
+func (r *resolver) AddTags(ctx context.Context, args struct {
+	Id   int32
+	Tags []string
+}) (*taskListResolver, error) {
+	state := stateFrom(ctx)
+	if err := state.requireScope(model.ScopeTasksWrite); err != nil {
+		return nil, err
+	}
+	if err := r.services.TaskList.AddTags(state.userId, int(args.Id), args.Tags); err != nil {
+		return nil, err
+	}
+	return r.taskList(state, int(args.Id))
+}
+
+// RemoveTag removes a tag from a task list and resolves it.
+func (r *resolver) RemoveTag(ctx context.Context, args struct {
+	Id  int32
+	Tag string
+}) (*taskListResolver, error) {
+	state := stateFrom(ctx)
+	if err := state.requireScope(model.ScopeTasksWrite); err != nil {
+		return nil, err
+	}
+	if err := r.services.TaskList.RemoveTag(state.userId, int(args.Id), args.Tag); err != nil {
+		return nil, err
+	}
+	return r.taskList(state, int(args.Id))
+}
+
+// AddItem adds a subtask and resolves its task list.
+func (r *resolver) AddItem(ctx context.Context, args struct {
+	ListId int32
+	Input  struct {
+		Title     string
+		DependsOn *[]graphql.ID
+	}
+}) (*taskListResolver, error) {
+	state := stateFrom(ctx)
+	if err := state.requireScope(model.ScopeTasksWrite); err != nil {
+		return nil, err
+	}
+	input := model.TaskItemInput{Title: args.Input.Title, DependsOn: idStrings(args.Input.DependsOn)}
+	if _, err := r.services.TaskItem.AddItem(state.userId, int(args.ListId), input); err != nil {
+		return nil, err
+	}
+	return r.taskList(state, int(args.ListId))
+}
+
+// UpdateItem updates a subtask and resolves its task list.
+func (r *resolver) UpdateItem(ctx context.Context, args struct {
+	ListId int32
+	ItemId graphql.ID
+	Input  struct {
+		Title     *string
+		Done      *bool
+		DependsOn *[]graphql.ID
+	}
+}) (*taskListResolver, error) {
+	state := stateFrom(ctx)
+	if err := state.requireScope(model.ScopeTasksWrite); err != nil {
+		return nil, err
+	}
+	input := model.UpdateTaskItemInput{Title: args.Input.Title, Done: args.Input.Done}
+	if args.Input.DependsOn != nil {
+		dependsOn := idStrings(args.Input.DependsOn)
+		input.DependsOn = &dependsOn
+	}
+	if err := r.services.TaskItem.UpdateItem(state.userId, int(args.ListId), string(args.ItemId), input); err != nil {
+		return nil, err
+	}
+	return r.taskList(state, int(args.ListId))
+}
+
+// DeleteItem deletes a subtask and resolves its task list.
+func (r *resolver) DeleteItem(ctx context.Context, args struct {
+	ListId int32
+	ItemId graphql.ID
+}) (*taskListResolver, error) {
+	state := stateFrom(ctx)
+	if err := state.requireScope(model.ScopeTasksWrite); err != nil {
+		return nil, err
+	}
+	if err := r.services.TaskItem.DeleteItem(state.userId, int(args.ListId), string(args.ItemId)); err != nil {
+		return nil, err
+	}
+	return r.taskList(state, int(args.ListId))
+}
+
+// taskList resolves the current state of a task list after a mutation.
+func (r *resolver) taskList(state *requestState, id int) (*taskListResolver, error) {
+	list, err := r.services.TaskList.GetById(state.userId, id)
+	if err != nil {
+		return nil, err
+	}
+	return newTaskListResolvers(state, []model.TaskList{list})[0], nil
+}
+
+// stringValue returns the string, or an empty one when it is not set.
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// timeValue converts an optional GraphQL time.
+func timeValue(t *graphql.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	return &t.Time
+}
+
+// idStrings converts optional GraphQL IDs to strings.
+func idStrings(ids *[]graphql.ID) []string {
+	if ids == nil {
+		return nil
+	}
+	strings := make([]string, 0, len(*ids))
+	for _, id := range *ids {
+		strings = append(strings, string(id))
+	}
+	return strings
+}
@@ -0,0 +1,110 @@
+package graph
+
+import (
+	"TaskManager/internal/domain/model"
+	"TaskManager/internal/service"
+	"context"
+	_ "embed"
+	"fmt"
+	"github.com/graph-gophers/graphql-go"
+	"slices"
+)
+
+//go:embed schema.graphql
+var schema string
+
+// Request is a GraphQL request as posted by clients.
+type Request struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// Server executes GraphQL requests against the services.
+type Server struct {
+	services *service.Service
+	schema   *graphql.Schema
+}
+
+// NewServer parses the schema and binds it to resolvers backed by the services.
+func NewServer(services *service.Service) *Server {
+	return &Server{
+		services: services,
+		schema:   graphql.MustParseSchema(schema, &resolver{services: services}),
+	}
+}
+
+// Exec runs a request on behalf of an authenticated user whose token grants the scopes.
+// Each request gets its own loaders, so batched results are never shared between users.
+func (s *Server) Exec(ctx context.Context, userId string, scopes []string, req Request) *graphql.Response {
+	state := newRequestState(s.services, userId)
+	state.scopes = scopes
+	ctx = context.WithValue(ctx, stateKey{}, state)
+	return s.schema.Exec(ctx, req.Query, req.OperationName, req.Variables)
+}
+
+// stateKey is the context key of the state of a request.
+type stateKey struct{}
+
+// requestState holds the authenticated user, the scopes of their token and the loaders of a request.
+type requestState struct {
+	userId     string
+	scopes     []string
+	users      *loader[string, model.User]
+	lists      *loader[int, model.TaskList]
+	workspaces *loader[string, model.Workspace]
+}
+
+// newRequestState creates the loaders of a request, each fetching its batch with one service call.
+func newRequestState(services *service.Service, userId string) *requestState {
+	return &requestState{
+		userId: userId,
+		users: newLoader(func(ids []string) (map[string]model.User, error) {
+			users, err := services.Authorization.GetUsers(ids)
+			if err != nil {
+				return nil, err
+			}
+			byId := make(map[string]model.User, len(users))
+			for _, user := range users {
+				byId[user.Id.Hex()] = user
+			}
+			return byId, nil
+		}),
+		lists: newLoader(func(ids []int) (map[int]model.TaskList, error) {
+			lists, err := services.TaskList.GetByIds(userId, ids)
+			if err != nil {
+				return nil, err
+			}
+			byId := make(map[int]model.TaskList, len(lists))
+			for _, list := range lists {
+				byId[list.Id] = list
+			}
+			return byId, nil
+		}),
+		// The user's workspaces are read in one call whatever the IDs requested.
+		workspaces: newLoader(func(ids []string) (map[string]model.Workspace, error) {
+			workspaces, err := services.Workspace.GetAll(userId)
+			if err != nil {
+				return nil, err
+			}
+			byId := make(map[string]model.Workspace, len(workspaces))
+			for _, workspace := range workspaces {
+				byId[workspace.Id.Hex()] = workspace
+			}
+			return byId, nil
+		}),
+	}
+}
+
+// stateFrom returns the state of the request being executed.
+func stateFrom(ctx context.Context) *requestState {
+	return ctx.Value(stateKey{}).(*requestState)
+}
+
+// requireScope fails when the token of the request was not granted the scope.
+func (s *requestState) requireScope(scope string) error {
+	if !slices.Contains(s.scopes, scope) {
+		return fmt.Errorf("token is missing the required scope %s", scope)
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+package graph
+
+import (
+	"TaskManager/internal/domain/model"
+	"TaskManager/internal/service"
+	"context"
+	"encoding/json"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// calls counts the service calls made while resolving a request.
+type calls struct {
+	mu     sync.Mutex
+	counts map[string]int
+}
+
+func (c *calls) add(name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.counts[name]++
+}
+
+type fakeAuth struct {
+	service.Authorization
+	calls *calls
+	users map[string]model.User
+}
+
+func (f *fakeAuth) GetUsers(ids []string) ([]model.User, error) {
+	f.calls.add("GetUsers")
+	var users []model.User
+	for _, id := range ids {
+		if user, ok := f.users[id]; ok {
+			users = append(users, user)
+		}
+	}
+	return users, nil
+}
+
+type fakeTaskLists struct {
+	service.TaskList
+	calls *calls
+	lists []model.TaskList
+}
+
+func (f *fakeTaskLists) GetAll(userId string, filter model.TaskListFilter) ([]model.TaskList, error) {
+	f.calls.add("GetAll")
+	return f.lists, nil
+}
+
+func (f *fakeTaskLists) GetByIds(userId string, listIds []int) ([]model.TaskList, error) {
+	f.calls.add("GetByIds")
+	var lists []model.TaskList
+	for _, list := range f.lists {
+		for _, id := range listIds {
+			if list.Id == id {
+				lists = append(lists, list)
+			}
+		}
+	}
+	return lists, nil
+}
+
+type fakeWorkspaces struct {
+	service.Workspace
+	calls      *calls
+	workspaces []model.Workspace
+}
+
+func (f *fakeWorkspaces) GetAll(userId string) ([]model.Workspace, error) {
+	f.calls.add("Workspace.GetAll")
+	return f.workspaces, nil
+}
+
+func TestServer_BatchesNestedFields(t *testing.T) {
+	alice, bob := bson.NewObjectID(), bson.NewObjectID()
+	team := bson.NewObjectID()
+	c := &calls{counts: map[string]int{}}
+	services := &service.Service{
+		Authorization: &fakeAuth{calls: c, users: map[string]model.User{
+			alice.Hex(): {Id: alice, Username: "alice"},
+			bob.Hex():   {Id: bob, Username: "bob"},
+		}},
+		TaskList: &fakeTaskLists{calls: c, lists: []model.TaskList{
+			{Id: 1, UserId: alice.Hex(), Title: "Plan", WorkspaceId: team.Hex()},
+			{Id: 2, UserId: bob.Hex(), Title: "Build", WorkspaceId: team.Hex(), DependsOn: []int{1}},
+			{Id: 3, UserId: alice.Hex(), Title: "Ship", DependsOn: []int{1, 2}},
+		}},
+		Workspace: &fakeWorkspaces{calls: c, workspaces: []model.Workspace{{Id: team, Name: "Team"}}},
+	}
+
+	resp := NewServer(services).Exec(context.Background(), alice.Hex(), model.Scopes, Request{Query: `{
+		me { username }
+		taskLists {
+			id
+			owner { username }
+			workspace { name }
+			dependsOn { title owner { username } }
+		}
+	}`})
+	if len(resp.Errors) > 0 {
+		t.Fatalf("Exec() errors = %v", resp.Errors)
+	}
+
+	var data struct {
+		Me        struct{ Username string }
+		TaskLists []struct {
+			Id        int
+			Owner     struct{ Username string }
+			Workspace *struct{ Name string }
+			DependsOn []struct {
+				Title string
+				Owner struct{ Username string }
+			}
+		}
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		t.Fatalf("decoding response: %v", err)
+	}
+	if data.Me.Username != "alice" || len(data.TaskLists) != 3 {
+		t.Fatalf("data = %+v", data)
+	}
+	ship := data.TaskLists[2]
+	if ship.Owner.Username != "alice" || ship.Workspace != nil || len(ship.DependsOn) != 2 ||
+		ship.DependsOn[1].Title != "Build" || ship.DependsOn[1].Owner.Username != "bob" {
+		t.Errorf("taskLists[2] = %+v", ship)
+	}
+	if build := data.TaskLists[1]; build.Workspace == nil || build.Workspace.Name != "Team" {
+		t.Errorf("taskLists[1].workspace = %+v", build.Workspace)
+	}
+
+	// One call per loader, however many lists reference the same kind of entity.
+	want := map[string]int{"GetAll": 1, "GetUsers": 1, "GetByIds": 1, "Workspace.GetAll": 1}
+	for name, count := range want {
+		if c.counts[name] != count {
+			t.Errorf("%s called %d times, want %d", name, c.counts[name], count)
+		}
+	}
+}
+
+func TestServer_MutationsRequireWriteScope(t *testing.T) {
+	services := &service.Service{TaskList: &fakeTaskLists{calls: &calls{counts: map[string]int{}}}}
+
+	resp := NewServer(services).Exec(context.Background(), bson.NewObjectID().Hex(), []string{model.ScopeTasksRead}, Request{
+		Query: `mutation { deleteTaskList(id: 1) }`,
+	})
+	if len(resp.Errors) != 1 || !strings.Contains(resp.Errors[0].Message, model.ScopeTasksWrite) {
+		t.Fatalf("Exec() errors = %v, want one naming %s", resp.Errors, model.ScopeTasksWrite)
+	}
+}
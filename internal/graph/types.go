@@ -0,0 +1,181 @@
+package graph
+
+import (
+	"TaskManager/internal/domain/model"
+	"github.com/graph-gophers/graphql-go"
+	"time"
+)
+
+// taskListResolver resolves the fields of a task list.
+type taskListResolver struct {
+	list  model.TaskList
+	state *requestState
+}
+
+// newTaskListResolvers wraps a level of task lists, priming the loaders with the owners, workspaces
+// and dependencies of all of them so each is fetched in one batch when first requested.
+func newTaskListResolvers(state *requestState, lists []model.TaskList) []*taskListResolver {
+	resolvers := make([]*taskListResolver, 0, len(lists))
+	for _, list := range lists {
+		state.users.Prime(list.UserId)
+		if list.WorkspaceId != "" {
+			state.workspaces.Prime(list.WorkspaceId)
+		}
+		state.lists.Prime(list.DependsOn...)
+		resolvers = append(resolvers, &taskListResolver{list: list, state: state})
+	}
+	return resolvers
+}
+
+func (r *taskListResolver) Id() int32 {
+	return int32(r.list.Id)
+}
+
+func (r *taskListResolver) Title() string {
+	return r.list.Title
+}
+
+func (r *taskListResolver) Description() string {
+	return r.list.Description
+}
+
+func (r *taskListResolver) Status() string {
+	return r.list.Status
+}
+
+// Priority resolves the priority, reporting lists stored without one as medium.
+func (r *taskListResolver) Priority() string {
+	if r.list.Priority == "" {
+		return model.PriorityMedium
+	}
+	return r.list.Priority
+}
+
+func (r *taskListResolver) DueDate() *graphql.Time {
+	return graphqlTime(r.list.DueDate)
+}
+
+func (r *taskListResolver) CompletedAt() *graphql.Time {
+	return graphqlTime(r.list.CompletedAt)
+}
+
+func (r *taskListResolver) CreatedAt() *graphql.Time {
+	return graphqlTime(r.list.CreatedAt)
+}
+
+func (r *taskListResolver) Tags() []string {
+	if r.list.Tags == nil {
+		return []string{}
+	}
+	return r.list.Tags
+}
+
+func (r *taskListResolver) Items() []*taskItemResolver {
+	resolvers := make([]*taskItemResolver, 0, len(r.list.Items))
+	for _, item := range r.list.Items {
+		resolvers = append(resolvers, &taskItemResolver{item: item})
+	}
+	return resolvers
+}
+
+// Owner resolves the user who created the list, batched with the owners of the other lists of the level.
+func (r *taskListResolver) Owner() (*userResolver, error) {
+	user, ok, err := r.state.users.Load(r.list.UserId)
+	if err != nil || !ok {
+		return nil, err
+	}
+	return &userResolver{user: user}, nil
+}
+
+// Workspace resolves the workspace of the list, or null when it has none.
+func (r *taskListResolver) Workspace() (*workspaceResolver, error) {
+	if r.list.WorkspaceId == "" {
+		return nil, nil
+	}
+	workspace, ok, err := r.state.workspaces.Load(r.list.WorkspaceId)
+	if err != nil || !ok {
+		return nil, err
+	}
+	return &workspaceResolver{workspace: workspace}, nil
+}
+
+// DependsOn resolves the dependencies the user can access, batched with those of the other lists of the level.
+func (r *taskListResolver) DependsOn() ([]*taskListResolver, error) {
+	lists, err := r.state.lists.LoadMany(r.list.DependsOn)
+	if err != nil {
+		return nil, err
+	}
+	return newTaskListResolvers(r.state, lists), nil
+}
+
+// taskItemResolver resolves the fields of a subtask.
+type taskItemResolver struct {
+	item model.TaskItem
+}
+
+func (r *taskItemResolver) Id() graphql.ID {
+	return graphql.ID(r.item.Id)
+}
+
+func (r *taskItemResolver) Title() string {
+	return r.item.Title
+}
+
+func (r *taskItemResolver) Done() bool {
+	return r.item.Done
+}
+
+func (r *taskItemResolver) DependsOn() []graphql.ID {
+	ids := make([]graphql.ID, 0, len(r.item.DependsOn))
+	for _, id := range r.item.DependsOn {
+		ids = append(ids, graphql.ID(id))
+	}
+	return ids
+}
+
+// userResolver resolves the public fields of a user.
+type userResolver struct {
+	user model.User
+}
+
+func (r *userResolver) Id() graphql.ID {
+	return graphql.ID(r.user.Id.Hex())
+}
+
+func (r *userResolver) Username() string {
+	return r.user.Username
+}
+
+// workspaceResolver resolves the fields of a workspace.
+type workspaceResolver struct {
+	workspace model.Workspace
+}
+
+func (r *workspaceResolver) Id() graphql.ID {
+	return graphql.ID(r.workspace.Id.Hex())
+}
+
+func (r *workspaceResolver) Name() string {
+	return r.workspace.Name
+}
+
+// tagResolver resolves a tag and its usage count.
+type tagResolver struct {
+	tag model.TagCount
+}
+
+func (r *tagResolver) Tag() string {
+	return r.tag.Tag
+}
+
+func (r *tagResolver) Count() int32 {
+	return int32(r.tag.Count)
+}
+
+// graphqlTime converts an optional time for GraphQL.
+func graphqlTime(t *time.Time) *graphql.Time {
+	if t == nil {
+		return nil
+	}
+	return &graphql.Time{Time: *t}
+}
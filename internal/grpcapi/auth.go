@@ -0,0 +1,146 @@
+package grpcapi
+
+import (
+	pb "TaskManager/api/taskmanager/v1"
+	"TaskManager/internal/domain/model"
+	"TaskManager/internal/service"
+	"context"
+	"errors"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"slices"
+	"strings"
+)
+
+const authorizationMetadata = "authorization"
+
+// publicMethods can be called without a bearer token.
+var publicMethods = map[string]bool{
+	pb.AuthService_Register_FullMethodName: true,
+	pb.AuthService_Login_FullMethodName:    true,
+}
+
+// methodScopes is the scope a token needs for each authenticated method, matching the REST routes.
+// Methods missing from it are refused.
+var methodScopes = map[string]string{
+	pb.TaskListService_ListTaskLists_FullMethodName:  model.ScopeTasksRead,
+	pb.TaskListService_GetTaskList_FullMethodName:    model.ScopeTasksRead,
+	pb.TaskListService_CreateTaskList_FullMethodName: model.ScopeTasksWrite,
+	pb.TaskListService_UpdateTaskList_FullMethodName: model.ScopeTasksWrite,
+	pb.TaskListService_DeleteTaskList_FullMethodName: model.ScopeTasksWrite,
+}
+
+// userIdKey is the context key of the ID of the authenticated user.
+type userIdKey struct{}
+
+// authInterceptor authenticates calls with the bearer token in the "authorization" metadata,
+// parsed the same way as the REST API's, checks that the token grants the scope of the method,
+// and logs failed calls.
+func authInterceptor(auth service.Authorization, tokens service.AccessToken, logger *zap.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		log := logger.With(zap.String("method", info.FullMethod))
+
+		if !publicMethods[info.FullMethod] {
+			userId, scopes, err := authenticate(ctx, auth, tokens)
+			if err != nil {
+				log.Error("Request failed", zap.String("message", err.Error()))
+				return nil, status.Error(codes.Unauthenticated, err.Error())
+			}
+			if err := requireScope(info.FullMethod, scopes); err != nil {
+				log.Error("Request failed", zap.String("message", err.Error()))
+				return nil, err
+			}
+			ctx = context.WithValue(ctx, userIdKey{}, userId)
+		}
+
+		resp, err := handler(ctx, req)
+		if err != nil {
+			log.Error("Request failed", zap.String("message", err.Error()))
+		}
+		return resp, err
+	}
+}
+
+// authenticate returns the ID of the user the bearer token of the call was issued to and the scopes
+// it grants. Like the REST API it accepts personal access tokens as well as the JWTs issued by login.
+func authenticate(ctx context.Context, auth service.Authorization, tokens service.AccessToken) (string, []string, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(authorizationMetadata)
+	if len(values) == 0 {
+		return "", nil, errors.New("no authorization metadata provided")
+	}
+
+	parts := strings.Split(values[0], " ")
+	if len(parts) != 2 || !strings.EqualFold(parts[0], "Bearer") {
+		return "", nil, errors.New("invalid authorization metadata")
+	}
+
+	if strings.HasPrefix(parts[1], model.AccessTokenPrefix) {
+		accessToken, err := tokens.Authenticate(parts[1])
+		if err != nil {
+			return "", nil, errors.New("invalid authorization token")
+		}
+		return accessToken.UserId, accessToken.Scopes, nil
+	}
+	userId, scopes, err := auth.ParseTokenScopes(parts[1])
+	if err != nil {
+		return "", nil, errors.New("invalid authorization token")
+	}
+	return userId, scopes, nil
+}
+
+// requireScope rejects a call whose token was not granted the scope of the method.
+func requireScope(method string, scopes []string) error {
+	scope, ok := methodScopes[method]
+	if !ok {
+		return status.Error(codes.PermissionDenied, "method has no scope")
+	}
+	if !slices.Contains(scopes, scope) {
+		return status.Error(codes.PermissionDenied, "token is missing the required scope "+scope)
+	}
+	return nil
+}
+
+// getUserId returns the ID of the user authenticated by the interceptor.
+func getUserId(ctx context.Context) (string, error) {
+	userId, ok := ctx.Value(userIdKey{}).(string)
+	if !ok {
+		return "", status.Error(codes.Unauthenticated, "user not found in context")
+	}
+	return userId, nil
+}
+
+// authServer implements the auth service on top of the authorization service.
+type authServer struct {
+	pb.UnimplementedAuthServiceServer
+	services *service.Service
+}
+
+// Register creates a user account.
+func (s *authServer) Register(ctx context.Context, req *pb.RegisterRequest) (*pb.RegisterResponse, error) {
+	id, err := s.services.Authorization.CreateUser(model.User{
+		Username: req.GetUsername(),
+		Password: req.GetPassword(),
+		Email:    req.GetEmail(),
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &pb.RegisterResponse{Id: int64(id)}, nil
+}
+
+// Login exchanges credentials for a bearer token. Users with two-factor authentication log in over
+// the REST API, which has the second step, or use a personal access token.
+func (s *authServer) Login(ctx context.Context, req *pb.LoginRequest) (*pb.LoginResponse, error) {
+	token, err := s.services.Authorization.GenerateToken(req.GetUsername(), req.GetPassword())
+	if errors.Is(err, service.ErrTwoFactorRequired) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	return &pb.LoginResponse{Token: token}, nil
+}
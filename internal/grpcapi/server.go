@@ -0,0 +1,19 @@
+package grpcapi
+
+import (
+	pb "TaskManager/api/taskmanager/v1"
+	"TaskManager/internal/service"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+// NewServer creates a gRPC server exposing the auth and task list operations of the services.
+// Every method except those of the auth service requires a bearer token.
+func NewServer(services *service.Service, logger *zap.Logger) *grpc.Server {
+	server := grpc.NewServer(
+		grpc.UnaryInterceptor(authInterceptor(services.Authorization, services.AccessToken, logger)),
+	)
+	pb.RegisterAuthServiceServer(server, &authServer{services: services})
+	pb.RegisterTaskListServiceServer(server, &taskListServer{services: services})
+	return server
+}
@@ -0,0 +1,182 @@
+package grpcapi
+
+import (
+	pb "TaskManager/api/taskmanager/v1"
+	"TaskManager/internal/domain/model"
+	"TaskManager/internal/service"
+	"context"
+	"errors"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"net"
+	"testing"
+)
+
+// fakeAuth accepts the token "valid" for user-1.
+type fakeAuth struct {
+	service.Authorization
+}
+
+func (fakeAuth) CreateUser(user model.User) (int, error) { return 1, nil }
+
+func (fakeAuth) GenerateToken(username, password string) (string, error) {
+	if password != "secret" {
+		return "", errors.New("invalid credentials")
+	}
+	return "valid", nil
+}
+
+func (fakeAuth) GetUsers(ids []string) ([]model.User, error) { return nil, nil }
+
+// ParseTokenScopes grants every scope to "valid" and only tasks:read to "read-only".
+func (fakeAuth) ParseTokenScopes(token string) (string, []string, error) {
+	switch token {
+	case "valid":
+		return "user-1", model.Scopes, nil
+	case "read-only":
+		return "user-1", []string{model.ScopeTasksRead}, nil
+	}
+	return "", nil, errors.New("invalid token")
+}
+
+// fakeAccessTokens accepts the personal access token "tmpat_valid" for user-1, with tasks:read only.
+type fakeAccessTokens struct {
+	service.AccessToken
+}
+
+func (fakeAccessTokens) Authenticate(value string) (model.AccessToken, error) {
+	if value != model.AccessTokenPrefix+"valid" {
+		return model.AccessToken{}, errors.New("invalid access token")
+	}
+	return model.AccessToken{UserId: "user-1", Scopes: []string{model.ScopeTasksRead}}, nil
+}
+
+// fakeTaskLists holds the lists of user-1 and records the user of the last call.
+type fakeTaskLists struct {
+	service.TaskList
+	lastUserId string
+	lists      map[int]model.TaskList
+}
+
+func (f *fakeTaskLists) GetById(userId string, listId int) (model.TaskList, error) {
+	f.lastUserId = userId
+	list, ok := f.lists[listId]
+	if !ok {
+		return model.TaskList{}, errors.New("task list not found")
+	}
+	return list, nil
+}
+
+func (f *fakeTaskLists) Update(userId string, listId int, input model.UpdateTaskListInput) error {
+	f.lastUserId = userId
+	list := f.lists[listId]
+	if input.Title != nil {
+		list.Title = *input.Title
+	}
+	f.lists[listId] = list
+	return nil
+}
+
+func newTestClient(t *testing.T, lists *fakeTaskLists) *grpc.ClientConn {
+	t.Helper()
+	listener := bufconn.Listen(1 << 20)
+	server := NewServer(&service.Service{Authorization: fakeAuth{}, AccessToken: fakeAccessTokens{}, TaskList: lists}, zap.NewNop())
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("grpc.NewClient() error = %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+func TestAuthInterceptor(t *testing.T) {
+	lists := &fakeTaskLists{lists: map[int]model.TaskList{7: {Id: 7, Title: "Groceries"}}}
+	client := pb.NewTaskListServiceClient(newTestClient(t, lists))
+
+	testTable := []struct {
+		name          string
+		authorization string
+		write         bool
+		want          codes.Code
+	}{
+		{name: "Missing Token", want: codes.Unauthenticated},
+		{name: "Malformed Metadata", authorization: "valid", want: codes.Unauthenticated},
+		{name: "Invalid Token", authorization: "Bearer forged", want: codes.Unauthenticated},
+		{name: "Valid Token", authorization: "Bearer valid", want: codes.OK},
+		{name: "Personal Access Token", authorization: "Bearer " + model.AccessTokenPrefix + "valid", want: codes.OK},
+		{name: "Write Without Write Scope", authorization: "Bearer read-only", write: true, want: codes.PermissionDenied},
+		{name: "Access Token Without Write Scope", authorization: "Bearer " + model.AccessTokenPrefix + "valid", write: true, want: codes.PermissionDenied},
+	}
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.authorization != "" {
+				ctx = metadata.AppendToOutgoingContext(ctx, authorizationMetadata, tt.authorization)
+			}
+			var err error
+			if tt.write {
+				_, err = client.DeleteTaskList(ctx, &pb.DeleteTaskListRequest{Id: 7})
+			} else {
+				_, err = client.GetTaskList(ctx, &pb.GetTaskListRequest{Id: 7})
+			}
+			if got := status.Code(err); got != tt.want {
+				t.Errorf("code = %v, want %v (err %v)", got, tt.want, err)
+			}
+		})
+	}
+	if lists.lastUserId != "user-1" {
+		t.Errorf("task list service called for user %q, want user-1", lists.lastUserId)
+	}
+}
+
+func TestTaskListServer(t *testing.T) {
+	lists := &fakeTaskLists{lists: map[int]model.TaskList{7: {Id: 7, Title: "Groceries", Tags: []string{"home"}, ReminderOffsets: []int{30}}}}
+	conn := newTestClient(t, lists)
+
+	login, err := pb.NewAuthServiceClient(conn).Login(context.Background(), &pb.LoginRequest{Username: "alice", Password: "secret"})
+	if err != nil {
+		t.Fatalf("Login() error = %v", err)
+	}
+	ctx := metadata.AppendToOutgoingContext(context.Background(), authorizationMetadata, "Bearer "+login.GetToken())
+	client := pb.NewTaskListServiceClient(conn)
+
+	title := "Weekly groceries"
+	if _, err := client.UpdateTaskList(ctx, &pb.UpdateTaskListRequest{Id: 7, Title: &title}); err != nil {
+		t.Fatalf("UpdateTaskList() error = %v", err)
+	}
+	list, err := client.GetTaskList(ctx, &pb.GetTaskListRequest{Id: 7})
+	if err != nil {
+		t.Fatalf("GetTaskList() error = %v", err)
+	}
+	if list.GetTitle() != title || len(list.GetTags()) != 1 || len(list.GetReminderOffsets()) != 1 || list.GetReminderOffsets()[0] != 30 {
+		t.Errorf("GetTaskList() = %v", list)
+	}
+
+	if _, err := client.GetTaskList(ctx, &pb.GetTaskListRequest{Id: 8}); status.Code(err) != codes.NotFound {
+		t.Errorf("GetTaskList() of a missing list code = %v, want %v", status.Code(err), codes.NotFound)
+	}
+	if _, err := client.GetTaskList(ctx, &pb.GetTaskListRequest{}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("GetTaskList() without an ID code = %v, want %v", status.Code(err), codes.InvalidArgument)
+	}
+}
+
+func TestMethodScopes(t *testing.T) {
+	// Every task list method needs a scope, or tokens could not call it.
+	for _, method := range pb.TaskListService_ServiceDesc.Methods {
+		fullMethod := "/" + pb.TaskListService_ServiceDesc.ServiceName + "/" + method.MethodName
+		if _, ok := methodScopes[fullMethod]; !ok {
+			t.Errorf("method %s has no scope", fullMethod)
+		}
+	}
+}
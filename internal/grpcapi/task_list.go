@@ -0,0 +1,192 @@
+package grpcapi
+
+import (
+	pb "TaskManager/api/taskmanager/v1"
+	"TaskManager/internal/domain/model"
+	"TaskManager/internal/service"
+	"context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
+)
+
+// taskListServer implements the task list service on top of the task list service layer.
+type taskListServer struct {
+	pb.UnimplementedTaskListServiceServer
+	services *service.Service
+}
+
+// ListTaskLists returns the caller's task lists matching the tag filter.
+func (s *taskListServer) ListTaskLists(ctx context.Context, req *pb.ListTaskListsRequest) (*pb.ListTaskListsResponse, error) {
+	userId, err := getUserId(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	lists, err := s.services.TaskList.GetAll(userId, model.TaskListFilter{
+		Tags:         req.GetTags(),
+		MatchAllTags: req.GetMatchAllTags(),
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &pb.ListTaskListsResponse{TaskLists: make([]*pb.TaskList, 0, len(lists))}
+	for _, list := range lists {
+		resp.TaskLists = append(resp.TaskLists, toProtoTaskList(list))
+	}
+	return resp, nil
+}
+
+// GetTaskList returns a single task list.
+func (s *taskListServer) GetTaskList(ctx context.Context, req *pb.GetTaskListRequest) (*pb.TaskList, error) {
+	userId, err := getUserId(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "task ID is required")
+	}
+
+	list, err := s.services.TaskList.GetById(userId, int(req.GetId()))
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	return toProtoTaskList(list), nil
+}
+
+// CreateTaskList creates a task list for the caller.
+func (s *taskListServer) CreateTaskList(ctx context.Context, req *pb.CreateTaskListRequest) (*pb.CreateTaskListResponse, error) {
+	userId, err := getUserId(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := s.services.TaskList.Create(userId, model.TaskList{
+		Title:           req.GetTitle(),
+		Description:     req.GetDescription(),
+		Priority:        req.GetPriority(),
+		DueDate:         fromProtoTime(req.GetDueDate()),
+		Tags:            req.GetTags(),
+		WorkspaceId:     req.GetWorkspaceId(),
+		ReminderOffsets: toInts(req.GetReminderOffsets()),
+		DependsOn:       toInts(req.GetDependsOn()),
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &pb.CreateTaskListResponse{Id: int64(id)}, nil
+}
+
+// UpdateTaskList updates the fields set in the request.
+func (s *taskListServer) UpdateTaskList(ctx context.Context, req *pb.UpdateTaskListRequest) (*pb.UpdateTaskListResponse, error) {
+	userId, err := getUserId(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "task ID is required")
+	}
+
+	err = s.services.TaskList.Update(userId, int(req.GetId()), model.UpdateTaskListInput{
+		Title:       req.Title,
+		Description: req.Description,
+		Status:      req.Status,
+		Priority:    req.Priority,
+		DueDate:     fromProtoTime(req.GetDueDate()),
+		WorkspaceId: req.WorkspaceId,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &pb.UpdateTaskListResponse{}, nil
+}
+
+// DeleteTaskList deletes a task list.
+func (s *taskListServer) DeleteTaskList(ctx context.Context, req *pb.DeleteTaskListRequest) (*pb.DeleteTaskListResponse, error) {
+	userId, err := getUserId(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "task ID is required")
+	}
+
+	if err := s.services.TaskList.Delete(userId, int(req.GetId())); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &pb.DeleteTaskListResponse{}, nil
+}
+
+// toProtoTaskList converts a task list to its protobuf message.
+func toProtoTaskList(list model.TaskList) *pb.TaskList {
+	return &pb.TaskList{
+		Id:              int64(list.Id),
+		UserId:          list.UserId,
+		WorkspaceId:     list.WorkspaceId,
+		Title:           list.Title,
+		Description:     list.Description,
+		Status:          list.Status,
+		Priority:        list.Priority,
+		DueDate:         toProtoTime(list.DueDate),
+		CompletedAt:     toProtoTime(list.CompletedAt),
+		CreatedAt:       toProtoTime(list.CreatedAt),
+		Tags:            list.Tags,
+		ReminderOffsets: toInt32s(list.ReminderOffsets),
+		DependsOn:       toInt64s(list.DependsOn),
+	}
+}
+
+// toProtoTime converts an optional time to a timestamp, nil when unset.
+func toProtoTime(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+// fromProtoTime converts an optional timestamp to a time, nil when unset.
+func fromProtoTime(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
+
+// toInts converts protobuf integers to ints.
+func toInts[T int32 | int64](values []T) []int {
+	if values == nil {
+		return nil
+	}
+	ints := make([]int, len(values))
+	for i, v := range values {
+		ints[i] = int(v)
+	}
+	return ints
+}
+
+// toInt32s converts ints to protobuf int32 values.
+func toInt32s(values []int) []int32 {
+	if values == nil {
+		return nil
+	}
+	ints := make([]int32, len(values))
+	for i, v := range values {
+		ints[i] = int32(v)
+	}
+	return ints
+}
+
+// toInt64s converts ints to protobuf int64 values.
+func toInt64s(values []int) []int64 {
+	if values == nil {
+		return nil
+	}
+	ints := make([]int64, len(values))
+	for i, v := range values {
+		ints[i] = int64(v)
+	}
+	return ints
+}
@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"TaskManager/internal/domain/model"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+	"net/http"
+)
+
+// createAccessToken issues a personal access token. The response is the only place the token is returned.
+func (h *Handler) createAccessToken(e echo.Context) error {
+	log := h.logger.With(
+		zap.String("handler", "createAccessToken"),
+	)
+
+	userId, err := getUserId(e)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusUnauthorized, err.Error())
+		return nil
+	}
+
+	var input model.AccessTokenInput
+	if err := e.Bind(&input); err != nil {
+		newErrorResponse(e, log, http.StatusBadRequest, err.Error())
+		return nil
+	}
+	log.Info("creating access token", zap.String("name", input.Name), zap.Strings("scopes", input.Scopes))
+
+	token, err := h.services.AccessToken.Create(userId, getScopes(e), input)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusBadRequest, err.Error())
+		return nil
+	}
+
+	log.Info("access token created successfully", zap.String("token_id", token.Id.Hex()))
+	return e.JSON(http.StatusOK, token)
+}
+
+// getAllAccessTokensResponse is the response structure for retrieving all personal access tokens.
+type getAllAccessTokensResponse struct {
+	Data []model.AccessToken `json:"data"`
+}
+
+// getAccessTokens retrieves the user's personal access tokens without their values.
+func (h *Handler) getAccessTokens(e echo.Context) error {
+	log := h.logger.With(
+		zap.String("handler", "getAccessTokens"),
+	)
+
+	userId, err := getUserId(e)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusUnauthorized, err.Error())
+		return nil
+	}
+
+	tokens, err := h.services.AccessToken.GetAll(userId)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusInternalServerError, err.Error())
+		return nil
+	}
+
+	log.Info("access tokens retrieved successfully", zap.Int("token_count", len(tokens)))
+	return e.JSON(http.StatusOK, getAllAccessTokensResponse{
+		Data: tokens,
+	})
+}
+
+// revokeAccessToken revokes a personal access token.
+func (h *Handler) revokeAccessToken(e echo.Context) error {
+	log := h.logger.With(
+		zap.String("handler", "revokeAccessToken"),
+	)
+
+	userId, err := getUserId(e)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusUnauthorized, err.Error())
+		return nil
+	}
+
+	tokenId := e.Param("id")
+	if err := h.services.AccessToken.Revoke(userId, tokenId); err != nil {
+		newErrorResponse(e, log, http.StatusNotFound, err.Error())
+		return nil
+	}
+
+	log.Info("access token revoked successfully", zap.String("token_id", tokenId))
+	return e.JSON(http.StatusOK, statusResponse{
+		Status: "Access token revoked successfully",
+	})
+}
@@ -0,0 +1,132 @@
+package handlers
+
+import (
+	"TaskManager/internal/domain/model"
+	"TaskManager/internal/service"
+	"errors"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type fakeJWTs struct {
+	service.Authorization
+}
+
+func (fakeJWTs) ParseToken(token string) (string, error) {
+	if token != "jwt" {
+		return "", errors.New("invalid token")
+	}
+	return "jwt-user", nil
+}
+
+func (fakeJWTs) ParseTokenScopes(token string) (string, []string, error) {
+	userId, err := fakeJWTs{}.ParseToken(token)
+	return userId, model.Scopes, err
+}
+
+type fakeAccessTokens struct {
+	service.AccessToken
+}
+
+func (fakeAccessTokens) Authenticate(value string) (model.AccessToken, error) {
+	switch value {
+	case model.AccessTokenPrefix + "valid":
+		return model.AccessToken{UserId: "pat-user", Scopes: model.Scopes}, nil
+	case model.AccessTokenPrefix + "read-only":
+		return model.AccessToken{UserId: "pat-user", Scopes: []string{model.ScopeTasksRead}}, nil
+	}
+	return model.AccessToken{}, service.ErrInvalidAccessToken
+}
+
+func (fakeAccessTokens) GetAll(userId string) ([]model.AccessToken, error) {
+	return []model.AccessToken{{UserId: userId, Name: "listed"}}, nil
+}
+
+func TestUserIdentityMiddleware_AccessTokens(t *testing.T) {
+	services := &service.Service{Authorization: fakeJWTs{}, AccessToken: fakeAccessTokens{}}
+	e := NewHandler(services, zap.NewNop(), Deprecation{}).InitRoutes(zap.NewNop())
+
+	testTable := []struct {
+		name       string
+		token      string
+		wantStatus int
+		wantUser   string
+	}{
+		{name: "JWT", token: "jwt", wantStatus: http.StatusOK, wantUser: "jwt-user"},
+		{name: "Personal Access Token", token: model.AccessTokenPrefix + "valid", wantStatus: http.StatusOK, wantUser: "pat-user"},
+		{name: "Revoked Personal Access Token", token: model.AccessTokenPrefix + "revoked", wantStatus: http.StatusUnauthorized},
+		{name: "Invalid JWT", token: "forged", wantStatus: http.StatusUnauthorized},
+	}
+
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/me/tokens", nil)
+			req.Header.Set(authorizationHeader, "Bearer "+tt.token)
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if tt.wantUser != "" && !strings.Contains(rec.Body.String(), `"user_id":"`+tt.wantUser+`"`) {
+				t.Errorf("body = %s, want tokens of %s", rec.Body.String(), tt.wantUser)
+			}
+		})
+	}
+}
+
+type fakeScopedTasks struct {
+	service.TaskList
+}
+
+func (fakeScopedTasks) GetAll(userId string, filter model.TaskListFilter) ([]model.TaskList, error) {
+	return nil, nil
+}
+
+func TestRequireScope(t *testing.T) {
+	services := &service.Service{Authorization: fakeJWTs{}, AccessToken: fakeAccessTokens{}, TaskList: fakeScopedTasks{}}
+	e := NewHandler(services, zap.NewNop(), Deprecation{}).InitRoutes(zap.NewNop())
+
+	testTable := []struct {
+		name       string
+		method     string
+		path       string
+		body       string
+		wantStatus int
+		wantScope  string
+	}{
+		{name: "Read With Read Scope", method: http.MethodGet, path: "/api/v1/tasks", wantStatus: http.StatusOK},
+		{name: "Write Without Write Scope", method: http.MethodPost, path: "/api/v1/tasks", body: `{"title":"t"}`, wantStatus: http.StatusForbidden, wantScope: model.ScopeTasksWrite},
+		{name: "Delete Without Write Scope", method: http.MethodDelete, path: "/api/v1/tasks/1", wantStatus: http.StatusForbidden, wantScope: model.ScopeTasksWrite},
+		{name: "Tokens Without Admin Scope", method: http.MethodGet, path: "/api/v1/me/tokens", wantStatus: http.StatusForbidden, wantScope: model.ScopeAccountAdmin},
+		{name: "Webhooks Without Admin Scope", method: http.MethodGet, path: "/api/v1/webhooks", wantStatus: http.StatusForbidden, wantScope: model.ScopeAccountAdmin},
+		{name: "Members Without Admin Scope", method: http.MethodPost, path: "/api/v1/workspaces/w1/members", body: `{"username":"bob"}`, wantStatus: http.StatusForbidden, wantScope: model.ScopeAccountAdmin},
+	}
+
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			req.Header.Set(authorizationHeader, "Bearer "+model.AccessTokenPrefix+"read-only")
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d (body %s)", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+			if tt.wantScope == "" {
+				return
+			}
+			if !strings.Contains(rec.Body.String(), tt.wantScope) {
+				t.Errorf("body = %s, want it to name %s", rec.Body.String(), tt.wantScope)
+			}
+			if got := rec.Header().Get(echo.HeaderWWWAuthenticate); !strings.Contains(got, `scope="`+tt.wantScope+`"`) {
+				t.Errorf("WWW-Authenticate = %q, want scope %s", got, tt.wantScope)
+			}
+		})
+	}
+}
@@ -0,0 +1,176 @@
+package handlers
+
+import (
+	"TaskManager/internal/domain/model"
+	"errors"
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+)
+
+const (
+	attachmentFormField = "file"
+	// multipartOverhead leaves room for the multipart boundaries and headers around the file.
+	multipartOverhead = 1 << 20
+)
+
+// uploadAttachment stores a file sent as multipart/form-data in the "file" field on a task.
+func (h *Handler) uploadAttachment(e echo.Context) error {
+	log := h.logger.With(
+		zap.String("handler", "uploadAttachment"),
+	)
+
+	userId, err := getUserId(e)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusUnauthorized, err.Error())
+		return nil
+	}
+
+	taskId, err := strconv.Atoi(e.Param("id"))
+	if taskId == 0 {
+		newErrorResponse(e, log, http.StatusBadRequest, "task ID is required")
+		return nil
+	}
+
+	maxSize := h.services.Attachment.MaxAttachmentSize()
+	req := e.Request()
+	req.Body = http.MaxBytesReader(e.Response(), req.Body, maxSize+multipartOverhead)
+
+	header, err := e.FormFile(attachmentFormField)
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			newErrorResponse(e, log, http.StatusRequestEntityTooLarge, fmt.Sprintf("attachment cannot be larger than %d bytes", maxSize))
+			return nil
+		}
+		newErrorResponse(e, log, http.StatusBadRequest, err.Error())
+		return nil
+	}
+	if header.Size > maxSize {
+		newErrorResponse(e, log, http.StatusRequestEntityTooLarge, fmt.Sprintf("attachment cannot be larger than %d bytes", maxSize))
+		return nil
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		newErrorResponse(e, log, http.StatusBadRequest, err.Error())
+		return nil
+	}
+	defer file.Close()
+
+	attachment, err := h.services.Attachment.CreateAttachment(userId, taskId, header.Filename, header.Size, file)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusInternalServerError, err.Error())
+		return nil
+	}
+
+	log.Info("attachment uploaded successfully", zap.Int("task_id", taskId), zap.String("attachment_id", attachment.Id.Hex()), zap.Int64("size", attachment.Size))
+	return e.JSON(http.StatusOK, attachment)
+}
+
+// getAllAttachmentsResponse is the response structure for retrieving the attachments of a task.
+type getAllAttachmentsResponse struct {
+	Data []model.Attachment `json:"data"`
+}
+
+// getAttachments retrieves the attachments of a task.
+func (h *Handler) getAttachments(e echo.Context) error {
+	log := h.logger.With(
+		zap.String("handler", "getAttachments"),
+	)
+
+	userId, err := getUserId(e)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusUnauthorized, err.Error())
+		return nil
+	}
+
+	taskId, err := strconv.Atoi(e.Param("id"))
+	if taskId == 0 {
+		newErrorResponse(e, log, http.StatusBadRequest, "task ID is required")
+		return nil
+	}
+
+	attachments, err := h.services.Attachment.GetAttachments(userId, taskId)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusInternalServerError, err.Error())
+		return nil
+	}
+
+	log.Info("attachments retrieved successfully", zap.Int("task_id", taskId), zap.Int("attachment_count", len(attachments)))
+	return e.JSON(http.StatusOK, getAllAttachmentsResponse{
+		Data: attachments,
+	})
+}
+
+// downloadAttachment streams the content of an attachment.
+func (h *Handler) downloadAttachment(e echo.Context) error {
+	log := h.logger.With(
+		zap.String("handler", "downloadAttachment"),
+	)
+
+	userId, err := getUserId(e)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusUnauthorized, err.Error())
+		return nil
+	}
+
+	taskId, err := strconv.Atoi(e.Param("id"))
+	if taskId == 0 {
+		newErrorResponse(e, log, http.StatusBadRequest, "task ID is required")
+		return nil
+	}
+
+	attachmentId := e.Param("attachmentId")
+	attachment, content, err := h.services.Attachment.OpenAttachment(userId, taskId, attachmentId)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusNotFound, err.Error())
+		return nil
+	}
+	defer content.Close()
+
+	header := e.Response().Header()
+	header.Set(echo.HeaderContentDisposition, mime.FormatMediaType("attachment", map[string]string{"filename": attachment.Filename}))
+	header.Set(echo.HeaderContentLength, strconv.FormatInt(attachment.Size, 10))
+	header.Set(echo.HeaderXContentTypeOptions, "nosniff")
+	header.Set(echo.HeaderContentType, attachment.ContentType)
+	e.Response().WriteHeader(http.StatusOK)
+	if _, err := io.Copy(e.Response(), content); err != nil {
+		log.Warn("attachment download interrupted", zap.String("attachment_id", attachmentId), zap.Error(err))
+	}
+	return nil
+}
+
+// deleteAttachment removes an attachment from a task.
+func (h *Handler) deleteAttachment(e echo.Context) error {
+	log := h.logger.With(
+		zap.String("handler", "deleteAttachment"),
+	)
+
+	userId, err := getUserId(e)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusUnauthorized, err.Error())
+		return nil
+	}
+
+	taskId, err := strconv.Atoi(e.Param("id"))
+	if taskId == 0 {
+		newErrorResponse(e, log, http.StatusBadRequest, "task ID is required")
+		return nil
+	}
+
+	attachmentId := e.Param("attachmentId")
+	if err := h.services.Attachment.DeleteAttachment(userId, taskId, attachmentId); err != nil {
+		newErrorResponse(e, log, http.StatusInternalServerError, err.Error())
+		return nil
+	}
+
+	log.Info("attachment deleted successfully", zap.Int("task_id", taskId), zap.String("attachment_id", attachmentId))
+	return e.JSON(http.StatusOK, statusResponse{
+		Status: "Attachment deleted successfully",
+	})
+}
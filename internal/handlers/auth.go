@@ -2,6 +2,8 @@ package handlers
 
 import (
 	"TaskManager/internal/domain/model"
+	"TaskManager/internal/service"
+	"errors"
 	"github.com/labstack/echo/v4"
 	"go.uber.org/zap"
 	"net/http"
@@ -27,7 +29,7 @@ func (h *Handler) register(e echo.Context) error {
 		return nil
 	}
 
-	log.Info("User registered successfully", zap.String("userID", string(id)))
+	log.Info("User registered successfully", zap.Int("userID", id))
 
 	return e.JSON(http.StatusOK, map[string]interface{}{
 		"id": id,
@@ -57,6 +59,11 @@ func (h *Handler) login(e echo.Context) error {
 		input.Username,
 		input.Password,
 	)
+	var challenge *service.TwoFactorChallenge
+	if errors.As(err, &challenge) {
+		log.Info("User login awaits the second factor")
+		return twoFactorRequired(e, challenge)
+	}
 	if err != nil {
 		newErrorResponse(e, log, http.StatusInternalServerError, err.Error())
 		return nil
@@ -68,3 +75,9 @@ func (h *Handler) login(e echo.Context) error {
 		"token": token,
 	})
 }
+
+// getJWKS publishes the public keys that verify access tokens, so other services can check them offline.
+func (h *Handler) getJWKS(e echo.Context) error {
+	e.Response().Header().Set(echo.HeaderCacheControl, "public, max-age=300")
+	return e.JSON(http.StatusOK, h.services.Authorization.PublicKeys())
+}
@@ -0,0 +1,204 @@
+package handlers
+
+import (
+	"TaskManager/internal/domain/model"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+	"net/http"
+	"strconv"
+)
+
+// createBoard creates a new kanban board.
+func (h *Handler) createBoard(e echo.Context) error {
+	log := h.logger.With(
+		zap.String("handler", "createBoard"),
+	)
+
+	userId, err := getUserId(e)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusUnauthorized, err.Error())
+		return nil
+	}
+
+	var input model.BoardInput
+	if err := e.Bind(&input); err != nil {
+		newErrorResponse(e, log, http.StatusBadRequest, err.Error())
+		return nil
+	}
+
+	id, err := h.services.Board.Create(userId, input)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusInternalServerError, err.Error())
+		return nil
+	}
+
+	log.Info("board created successfully", zap.String("board_id", id))
+	return e.JSON(http.StatusOK, map[string]interface{}{
+		"id": id,
+	})
+}
+
+// getAllBoardsResponse is the response structure for retrieving all boards.
+type getAllBoardsResponse struct {
+	Data []model.Board `json:"data"`
+}
+
+// getBoards retrieves the boards the user can access.
+func (h *Handler) getBoards(e echo.Context) error {
+	log := h.logger.With(
+		zap.String("handler", "getBoards"),
+	)
+
+	userId, err := getUserId(e)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusUnauthorized, err.Error())
+		return nil
+	}
+
+	boards, err := h.services.Board.GetAll(userId)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusInternalServerError, err.Error())
+		return nil
+	}
+
+	log.Info("boards retrieved successfully", zap.Int("board_count", len(boards)))
+	return e.JSON(http.StatusOK, getAllBoardsResponse{
+		Data: boards,
+	})
+}
+
+// getBoardByID retrieves a board with its columns and their ordered tasks.
+func (h *Handler) getBoardByID(e echo.Context) error {
+	log := h.logger.With(
+		zap.String("handler", "getBoardByID"),
+	)
+
+	userId, err := getUserId(e)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusUnauthorized, err.Error())
+		return nil
+	}
+
+	board, err := h.services.Board.GetById(userId, e.Param("id"))
+	if err != nil {
+		newErrorResponse(e, log, http.StatusNotFound, err.Error())
+		return nil
+	}
+
+	return e.JSON(http.StatusOK, board)
+}
+
+// deleteBoard deletes a board.
+func (h *Handler) deleteBoard(e echo.Context) error {
+	log := h.logger.With(
+		zap.String("handler", "deleteBoard"),
+	)
+
+	userId, err := getUserId(e)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusUnauthorized, err.Error())
+		return nil
+	}
+
+	boardId := e.Param("id")
+	if err := h.services.Board.Delete(userId, boardId); err != nil {
+		newErrorResponse(e, log, http.StatusInternalServerError, err.Error())
+		return nil
+	}
+
+	log.Info("board deleted successfully", zap.String("board_id", boardId))
+	return e.JSON(http.StatusOK, statusResponse{
+		Status: "Board deleted successfully",
+	})
+}
+
+// addBoardColumn appends a column to a board.
+func (h *Handler) addBoardColumn(e echo.Context) error {
+	log := h.logger.With(
+		zap.String("handler", "addBoardColumn"),
+	)
+
+	userId, err := getUserId(e)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusUnauthorized, err.Error())
+		return nil
+	}
+
+	var input model.BoardColumnInput
+	if err := e.Bind(&input); err != nil {
+		newErrorResponse(e, log, http.StatusBadRequest, err.Error())
+		return nil
+	}
+
+	boardId := e.Param("id")
+	columnId, err := h.services.Board.AddColumn(userId, boardId, input)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusInternalServerError, err.Error())
+		return nil
+	}
+
+	log.Info("board column added successfully", zap.String("board_id", boardId), zap.String("column_id", columnId))
+	return e.JSON(http.StatusOK, map[string]interface{}{
+		"id": columnId,
+	})
+}
+
+// removeBoardColumn removes a column from a board.
+func (h *Handler) removeBoardColumn(e echo.Context) error {
+	log := h.logger.With(
+		zap.String("handler", "removeBoardColumn"),
+	)
+
+	userId, err := getUserId(e)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusUnauthorized, err.Error())
+		return nil
+	}
+
+	boardId, columnId := e.Param("id"), e.Param("columnId")
+	if err := h.services.Board.RemoveColumn(userId, boardId, columnId); err != nil {
+		newErrorResponse(e, log, http.StatusInternalServerError, err.Error())
+		return nil
+	}
+
+	log.Info("board column removed successfully", zap.String("board_id", boardId), zap.String("column_id", columnId))
+	return e.JSON(http.StatusOK, statusResponse{
+		Status: "Column removed successfully",
+	})
+}
+
+// moveTask moves a task to a board column between two neighbouring tasks.
+func (h *Handler) moveTask(e echo.Context) error {
+	log := h.logger.With(
+		zap.String("handler", "moveTask"),
+	)
+
+	userId, err := getUserId(e)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusUnauthorized, err.Error())
+		return nil
+	}
+
+	taskId, err := strconv.Atoi(e.Param("id"))
+	if taskId == 0 {
+		newErrorResponse(e, log, http.StatusBadRequest, "task ID is required")
+		return nil
+	}
+
+	var input model.MoveTaskListInput
+	if err := e.Bind(&input); err != nil {
+		newErrorResponse(e, log, http.StatusBadRequest, err.Error())
+		return nil
+	}
+	log.Info("moving task", zap.Int("task_id", taskId), zap.Any("input", input))
+
+	if err := h.services.Board.Move(userId, taskId, input); err != nil {
+		newErrorResponse(e, log, http.StatusInternalServerError, err.Error())
+		return nil
+	}
+
+	log.Info("task moved successfully", zap.Int("task_id", taskId))
+	return e.JSON(http.StatusOK, statusResponse{
+		Status: "Task moved successfully",
+	})
+}
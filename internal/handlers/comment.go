@@ -0,0 +1,220 @@
+package handlers
+
+import (
+	"TaskManager/internal/domain/model"
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+	"net/http"
+	"strconv"
+)
+
+const (
+	offsetParam = "offset"
+	limitParam  = "limit"
+)
+
+// getCommentsResponse is the response structure for a page of comment threads.
+type getCommentsResponse struct {
+	Data  []model.CommentThread `json:"data"`
+	Total int64                 `json:"total"`
+}
+
+// getComments retrieves a page of the comment threads of a task.
+func (h *Handler) getComments(e echo.Context) error {
+	log := h.logger.With(
+		zap.String("handler", "getComments"),
+	)
+
+	userId, err := getUserId(e)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusUnauthorized, err.Error())
+		return nil
+	}
+
+	taskId, err := strconv.Atoi(e.Param("id"))
+	if taskId == 0 {
+		newErrorResponse(e, log, http.StatusBadRequest, "task ID is required")
+		return nil
+	}
+
+	page, err := parsePage(e)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusBadRequest, err.Error())
+		return nil
+	}
+
+	threads, total, err := h.services.Comment.GetComments(userId, taskId, page)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusInternalServerError, err.Error())
+		return nil
+	}
+
+	log.Info("comments retrieved successfully", zap.Int("task_id", taskId), zap.Int("thread_count", len(threads)))
+	return e.JSON(http.StatusOK, getCommentsResponse{
+		Data:  threads,
+		Total: total,
+	})
+}
+
+// createComment posts a comment or a reply on a task.
+func (h *Handler) createComment(e echo.Context) error {
+	log := h.logger.With(
+		zap.String("handler", "createComment"),
+	)
+
+	userId, err := getUserId(e)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusUnauthorized, err.Error())
+		return nil
+	}
+
+	taskId, err := strconv.Atoi(e.Param("id"))
+	if taskId == 0 {
+		newErrorResponse(e, log, http.StatusBadRequest, "task ID is required")
+		return nil
+	}
+
+	var input model.CommentInput
+	if err := e.Bind(&input); err != nil {
+		newErrorResponse(e, log, http.StatusBadRequest, err.Error())
+		return nil
+	}
+
+	id, err := h.services.Comment.CreateComment(userId, taskId, input)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusInternalServerError, err.Error())
+		return nil
+	}
+
+	log.Info("comment created successfully", zap.Int("task_id", taskId), zap.String("comment_id", id))
+	return e.JSON(http.StatusOK, map[string]interface{}{
+		"id": id,
+	})
+}
+
+// updateComment edits a comment written by the user.
+func (h *Handler) updateComment(e echo.Context) error {
+	log := h.logger.With(
+		zap.String("handler", "updateComment"),
+	)
+
+	userId, err := getUserId(e)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusUnauthorized, err.Error())
+		return nil
+	}
+
+	taskId, err := strconv.Atoi(e.Param("id"))
+	if taskId == 0 {
+		newErrorResponse(e, log, http.StatusBadRequest, "task ID is required")
+		return nil
+	}
+
+	var input model.CommentInput
+	if err := e.Bind(&input); err != nil {
+		newErrorResponse(e, log, http.StatusBadRequest, err.Error())
+		return nil
+	}
+
+	commentId := e.Param("commentId")
+	if err := h.services.Comment.UpdateComment(userId, taskId, commentId, input); err != nil {
+		newErrorResponse(e, log, http.StatusInternalServerError, err.Error())
+		return nil
+	}
+
+	log.Info("comment updated successfully", zap.Int("task_id", taskId), zap.String("comment_id", commentId))
+	return e.JSON(http.StatusOK, statusResponse{
+		Status: "Comment updated successfully",
+	})
+}
+
+// deleteComment deletes a comment written by the user together with its replies.
+func (h *Handler) deleteComment(e echo.Context) error {
+	log := h.logger.With(
+		zap.String("handler", "deleteComment"),
+	)
+
+	userId, err := getUserId(e)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusUnauthorized, err.Error())
+		return nil
+	}
+
+	taskId, err := strconv.Atoi(e.Param("id"))
+	if taskId == 0 {
+		newErrorResponse(e, log, http.StatusBadRequest, "task ID is required")
+		return nil
+	}
+
+	commentId := e.Param("commentId")
+	if err := h.services.Comment.DeleteComment(userId, taskId, commentId); err != nil {
+		newErrorResponse(e, log, http.StatusInternalServerError, err.Error())
+		return nil
+	}
+
+	log.Info("comment deleted successfully", zap.Int("task_id", taskId), zap.String("comment_id", commentId))
+	return e.JSON(http.StatusOK, statusResponse{
+		Status: "Comment deleted successfully",
+	})
+}
+
+// getMentionsResponse is the response structure for a page of mention notifications.
+type getMentionsResponse struct {
+	Data  []model.Mention `json:"data"`
+	Total int64           `json:"total"`
+}
+
+// getMentions retrieves a page of the comments that mention the user.
+func (h *Handler) getMentions(e echo.Context) error {
+	log := h.logger.With(
+		zap.String("handler", "getMentions"),
+	)
+
+	userId, err := getUserId(e)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusUnauthorized, err.Error())
+		return nil
+	}
+
+	page, err := parsePage(e)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusBadRequest, err.Error())
+		return nil
+	}
+
+	mentions, total, err := h.services.Comment.GetMentions(userId, page)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusInternalServerError, err.Error())
+		return nil
+	}
+
+	log.Info("mentions retrieved successfully", zap.Int("mention_count", len(mentions)))
+	return e.JSON(http.StatusOK, getMentionsResponse{
+		Data:  mentions,
+		Total: total,
+	})
+}
+
+// parsePage reads the offset and limit query parameters. Missing values are left at zero for the service defaults.
+func parsePage(e echo.Context) (model.Page, error) {
+	var page model.Page
+	for _, param := range []struct {
+		name  string
+		value *int
+	}{
+		{offsetParam, &page.Offset},
+		{limitParam, &page.Limit},
+	} {
+		value := e.QueryParam(param.name)
+		if value == isEmptyString {
+			continue
+		}
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 0 {
+			return model.Page{}, fmt.Errorf("%s must be a non-negative integer", param.name)
+		}
+		*param.value = n
+	}
+	return page, nil
+}
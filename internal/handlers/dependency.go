@@ -0,0 +1,142 @@
+package handlers
+
+import (
+	"TaskManager/internal/domain/model"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+	"net/http"
+	"strconv"
+)
+
+// addDependency makes a task depend on another task.
+func (h *Handler) addDependency(e echo.Context) error {
+	log := h.logger.With(
+		zap.String("handler", "addDependency"),
+	)
+
+	userId, err := getUserId(e)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusUnauthorized, err.Error())
+		return nil
+	}
+
+	taskId, err := strconv.Atoi(e.Param("id"))
+	if taskId == 0 {
+		newErrorResponse(e, log, http.StatusBadRequest, "task ID is required")
+		return nil
+	}
+
+	var input model.DependencyInput
+	if err := e.Bind(&input); err != nil {
+		newErrorResponse(e, log, http.StatusBadRequest, err.Error())
+		return nil
+	}
+	if input.DependsOn == 0 {
+		newErrorResponse(e, log, http.StatusBadRequest, "dependency task ID is required")
+		return nil
+	}
+
+	if err := h.services.Dependency.AddDependency(userId, taskId, input.DependsOn); err != nil {
+		newErrorResponse(e, log, http.StatusInternalServerError, err.Error())
+		return nil
+	}
+
+	log.Info("dependency added successfully", zap.Int("task_id", taskId), zap.Int("depends_on", input.DependsOn))
+	return e.JSON(http.StatusOK, statusResponse{
+		Status: "Dependency added successfully",
+	})
+}
+
+// removeDependency removes a dependency from a task.
+func (h *Handler) removeDependency(e echo.Context) error {
+	log := h.logger.With(
+		zap.String("handler", "removeDependency"),
+	)
+
+	userId, err := getUserId(e)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusUnauthorized, err.Error())
+		return nil
+	}
+
+	taskId, err := strconv.Atoi(e.Param("id"))
+	if taskId == 0 {
+		newErrorResponse(e, log, http.StatusBadRequest, "task ID is required")
+		return nil
+	}
+	dependsOnId, err := strconv.Atoi(e.Param("dependsOnId"))
+	if dependsOnId == 0 {
+		newErrorResponse(e, log, http.StatusBadRequest, "dependency task ID is required")
+		return nil
+	}
+
+	if err := h.services.Dependency.RemoveDependency(userId, taskId, dependsOnId); err != nil {
+		newErrorResponse(e, log, http.StatusInternalServerError, err.Error())
+		return nil
+	}
+
+	log.Info("dependency removed successfully", zap.Int("task_id", taskId), zap.Int("depends_on", dependsOnId))
+	return e.JSON(http.StatusOK, statusResponse{
+		Status: "Dependency removed successfully",
+	})
+}
+
+// getDependencies retrieves the tasks a task depends on.
+func (h *Handler) getDependencies(e echo.Context) error {
+	log := h.logger.With(
+		zap.String("handler", "getDependencies"),
+	)
+
+	userId, err := getUserId(e)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusUnauthorized, err.Error())
+		return nil
+	}
+
+	taskId, err := strconv.Atoi(e.Param("id"))
+	if taskId == 0 {
+		newErrorResponse(e, log, http.StatusBadRequest, "task ID is required")
+		return nil
+	}
+
+	lists, err := h.services.Dependency.GetDependencies(userId, taskId)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusInternalServerError, err.Error())
+		return nil
+	}
+
+	log.Info("dependencies retrieved successfully", zap.Int("task_id", taskId), zap.Int("task_count", len(lists)))
+	return e.JSON(http.StatusOK, getAllTasksResponse{
+		Data: lists,
+	})
+}
+
+// getDependents retrieves the tasks that depend on a task.
+func (h *Handler) getDependents(e echo.Context) error {
+	log := h.logger.With(
+		zap.String("handler", "getDependents"),
+	)
+
+	userId, err := getUserId(e)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusUnauthorized, err.Error())
+		return nil
+	}
+
+	taskId, err := strconv.Atoi(e.Param("id"))
+	if taskId == 0 {
+		newErrorResponse(e, log, http.StatusBadRequest, "task ID is required")
+		return nil
+	}
+
+	lists, err := h.services.Dependency.GetDependents(userId, taskId)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusInternalServerError, err.Error())
+		return nil
+	}
+
+	log.Info("dependents retrieved successfully", zap.Int("task_id", taskId), zap.Int("task_count", len(lists)))
+	return e.JSON(http.StatusOK, getAllTasksResponse{
+		Data: lists,
+	})
+}
@@ -0,0 +1,43 @@
+package handlers
+
+import (
+	_ "embed"
+	"github.com/labstack/echo/v4"
+	"net/http"
+)
+
+// openAPISpec is the OpenAPI 3 description of the routes registered in InitRoutes.
+// openapi_test.go fails when the two drift apart.
+//
+//go:embed openapi.json
+var openAPISpec []byte
+
+// swaggerUIPage renders the specification with Swagger UI loaded from a CDN.
+const swaggerUIPage = `<!DOCTYPE html>
+<html lang="en">
+<head>
+  <meta charset="utf-8">
+  <title>TaskManager API</title>
+  <link rel="stylesheet" href="https://unpkg.com/swagger-ui-dist@5/swagger-ui.css">
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="https://unpkg.com/swagger-ui-dist@5/swagger-ui-bundle.js" crossorigin></script>
+  <script>
+    window.onload = () => {
+      window.ui = SwaggerUIBundle({url: "/openapi.json", dom_id: "#swagger-ui"});
+    };
+  </script>
+</body>
+</html>
+`
+
+// getOpenAPISpec serves the OpenAPI specification of the API.
+func (h *Handler) getOpenAPISpec(e echo.Context) error {
+	return e.Blob(http.StatusOK, echo.MIMEApplicationJSON, openAPISpec)
+}
+
+// getDocs serves the interactive API documentation.
+func (h *Handler) getDocs(e echo.Context) error {
+	return e.HTML(http.StatusOK, swaggerUIPage)
+}
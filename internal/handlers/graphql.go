@@ -0,0 +1,38 @@
+package handlers
+
+import (
+	"TaskManager/internal/graph"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+	"net/http"
+)
+
+// graphql executes a GraphQL query or mutation for the user.
+// Errors of the operation are reported in the response body with a 200 status, as GraphQL clients expect.
+func (h *Handler) graphql(e echo.Context) error {
+	log := h.logger.With(
+		zap.String("handler", "graphql"),
+	)
+
+	userId, err := getUserId(e)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusUnauthorized, err.Error())
+		return nil
+	}
+
+	var req graph.Request
+	if err := e.Bind(&req); err != nil {
+		newErrorResponse(e, log, http.StatusBadRequest, err.Error())
+		return nil
+	}
+	if req.Query == isEmptyString {
+		newErrorResponse(e, log, http.StatusBadRequest, "query is required")
+		return nil
+	}
+
+	resp := h.graph.Exec(e.Request().Context(), userId, getScopes(e), req)
+	for _, gqlErr := range resp.Errors {
+		log.Error("GraphQL operation failed", zap.String("operation", req.OperationName), zap.String("message", gqlErr.Message))
+	}
+	return e.JSON(http.StatusOK, resp)
+}
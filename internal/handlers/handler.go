@@ -1,6 +1,8 @@
 package handlers
 
 import (
+	"TaskManager/internal/domain/model"
+	"TaskManager/internal/graph"
 	"TaskManager/internal/service"
 	"github.com/labstack/echo/v4"
 	"go.uber.org/zap"
@@ -8,33 +10,141 @@ import (
 
 // Package handler provides HTTP request handlers for the application.
 type Handler struct {
-	services *service.Service
-	logger   *zap.Logger
+	services    *service.Service
+	graph       *graph.Server
+	logger      *zap.Logger
+	deprecation Deprecation
 }
 
 // NewHandler creates a new Handler instance with the provided services.
-func NewHandler(services *service.Service, logger *zap.Logger) *Handler {
+// The deprecation dates are announced on the unversioned legacy routes.
+func NewHandler(services *service.Service, logger *zap.Logger, deprecation Deprecation) *Handler {
 	return &Handler{
-		services: services,
-		logger:   logger,
+		services:    services,
+		graph:       graph.NewServer(services),
+		logger:      logger,
+		deprecation: deprecation,
 	}
 }
 
 // InitRoutes initializes the routes for the HTTP server.
+// Each API version is served under its own prefix; the unversioned paths of the first release remain
+// as deprecated aliases of v1.
 func (h *Handler) InitRoutes(logger *zap.Logger) *echo.Echo {
 	e := echo.New()
 
+	e.GET("/openapi.json", h.getOpenAPISpec)
+	e.GET("/docs", h.getDocs)
+	e.POST("/graphql", h.graphql, h.userIdentityMiddleware, h.requireScope(model.ScopeTasksRead))
+	e.GET("/.well-known/jwks.json", h.getJWKS)
+	e.GET("/auth/oidc/login", h.oidcLogin)
+	e.GET("/auth/oidc/callback", h.oidcCallback)
+
+	h.initV1Routes(e.Group(apiV1Prefix))
+	h.initV1Routes(withMiddleware(e, h.deprecatedMiddleware(apiV1Prefix)))
+
+	logger.Info("Routes initialized")
+
+	return e
+}
+
+// initV1Routes registers the routes of version 1 of the API.
+// Every authenticated route names the scope a token needs for it.
+func (h *Handler) initV1Routes(e router) {
+	readTasks := h.requireScope(model.ScopeTasksRead)
+	writeTasks := h.requireScope(model.ScopeTasksWrite)
+	adminAccount := h.requireScope(model.ScopeAccountAdmin)
+
 	e.POST("/register", h.register)
 	e.POST("/login", h.login)
+	e.POST("/login/2fa", h.verifyTwoFactor)
+
+	e.GET("/tasks/stream", h.streamTasks, h.streamIdentityMiddleware, readTasks)
+	e.GET("/tasks/ws", h.streamTasksWebSocket, h.streamIdentityMiddleware, readTasks)
 
 	auth := e.Group("/tasks", h.userIdentityMiddleware)
-	auth.GET("", h.getTasks)
-	auth.GET("/:id", h.getTaskByID)
-	auth.POST("", h.createTask)
-	auth.PUT("/:id", h.updateTask)
-	auth.DELETE("/:id", h.deleteTask)
+	auth.POST("/stream/ticket", h.createStreamTicket, readTasks)
+	auth.GET("", h.getTasks, readTasks)
+	auth.GET("/:id", h.getTaskByID, readTasks)
+	auth.POST("", h.createTask, writeTasks)
+	auth.PUT("/:id", h.updateTask, writeTasks)
+	auth.DELETE("/:id", h.deleteTask, writeTasks)
+	auth.PUT("/:id/recurrence", h.setRecurrence, writeTasks)
+	auth.DELETE("/:id/recurrence", h.stopRecurrence, writeTasks)
+	auth.POST("/:id/tags", h.addTags, writeTasks)
+	auth.DELETE("/:id/tags/:tag", h.removeTag, writeTasks)
+	auth.POST("/:id/move", h.moveTask, writeTasks)
+	auth.GET("/:id/dependencies", h.getDependencies, readTasks)
+	auth.POST("/:id/dependencies", h.addDependency, writeTasks)
+	auth.DELETE("/:id/dependencies/:dependsOnId", h.removeDependency, writeTasks)
+	auth.GET("/:id/dependents", h.getDependents, readTasks)
+	auth.POST("/:id/items", h.addTaskItem, writeTasks)
+	auth.PUT("/:id/items/:itemId", h.updateTaskItem, writeTasks)
+	auth.DELETE("/:id/items/:itemId", h.deleteTaskItem, writeTasks)
+	auth.GET("/:id/comments", h.getComments, readTasks)
+	auth.POST("/:id/comments", h.createComment, writeTasks)
+	auth.PUT("/:id/comments/:commentId", h.updateComment, writeTasks)
+	auth.DELETE("/:id/comments/:commentId", h.deleteComment, writeTasks)
+	auth.GET("/:id/attachments", h.getAttachments, readTasks)
+	auth.POST("/:id/attachments", h.uploadAttachment, writeTasks)
+	auth.GET("/:id/attachments/:attachmentId", h.downloadAttachment, readTasks)
+	auth.DELETE("/:id/attachments/:attachmentId", h.deleteAttachment, writeTasks)
+	auth.POST("/:id/timer/start", h.startTimer, writeTasks)
+	auth.POST("/:id/timer/stop", h.stopTimer, writeTasks)
+	auth.GET("/:id/time", h.getTimeEntries, readTasks)
+	auth.POST("/:id/time", h.addTimeEntry, writeTasks)
+	auth.DELETE("/:id/time/:entryId", h.deleteTimeEntry, writeTasks)
 
-	logger.Info("Routes initialized")
+	templates := e.Group("/templates", h.userIdentityMiddleware)
+	templates.GET("", h.getTemplates, readTasks)
+	templates.GET("/:id", h.getTemplateByID, readTasks)
+	templates.POST("", h.createTemplate, writeTasks)
+	templates.PUT("/:id", h.updateTemplate, writeTasks)
+	templates.DELETE("/:id", h.deleteTemplate, writeTasks)
+	templates.POST("/:id/instantiate", h.instantiateTemplate, writeTasks)
 
-	return e
+	reports := e.Group("/reports", h.userIdentityMiddleware)
+	reports.GET("/time", h.getTimeReport, readTasks)
+
+	e.GET("/stats", h.getStats, h.userIdentityMiddleware, readTasks)
+
+	mentions := e.Group("/mentions", h.userIdentityMiddleware)
+	mentions.GET("", h.getMentions, readTasks)
+
+	tags := e.Group("/tags", h.userIdentityMiddleware)
+	tags.GET("", h.getTags, readTasks)
+
+	workspaces := e.Group("/workspaces", h.userIdentityMiddleware)
+	workspaces.GET("", h.getWorkspaces, readTasks)
+	workspaces.GET("/:id", h.getWorkspaceByID, readTasks)
+	workspaces.POST("", h.createWorkspace, writeTasks)
+	workspaces.PUT("/:id", h.updateWorkspace, writeTasks)
+	workspaces.DELETE("/:id", h.deleteWorkspace, writeTasks)
+	workspaces.POST("/:id/members", h.addWorkspaceMember, adminAccount)
+	workspaces.PUT("/:id/members/:userId", h.updateWorkspaceMember, adminAccount)
+	workspaces.DELETE("/:id/members/:userId", h.removeWorkspaceMember, adminAccount)
+
+	boards := e.Group("/boards", h.userIdentityMiddleware)
+	boards.GET("", h.getBoards, readTasks)
+	boards.GET("/:id", h.getBoardByID, readTasks)
+	boards.POST("", h.createBoard, writeTasks)
+	boards.DELETE("/:id", h.deleteBoard, writeTasks)
+	boards.POST("/:id/columns", h.addBoardColumn, writeTasks)
+	boards.DELETE("/:id/columns/:columnId", h.removeBoardColumn, writeTasks)
+
+	me := e.Group("/me", h.userIdentityMiddleware)
+	me.GET("/tokens", h.getAccessTokens, adminAccount)
+	me.POST("/tokens", h.createAccessToken, adminAccount)
+	me.DELETE("/tokens/:id", h.revokeAccessToken, adminAccount)
+	me.POST("/2fa", h.enrollTwoFactor, adminAccount)
+	me.POST("/2fa/confirm", h.confirmTwoFactor, adminAccount)
+	me.POST("/2fa/disable", h.disableTwoFactor, adminAccount)
+
+	// Webhooks send task data to servers of the user's choosing, so managing them needs the admin scope.
+	webhooks := e.Group("/webhooks", h.userIdentityMiddleware)
+	webhooks.GET("", h.getWebhooks, adminAccount)
+	webhooks.GET("/:id", h.getWebhookByID, adminAccount)
+	webhooks.POST("", h.createWebhook, adminAccount)
+	webhooks.DELETE("/:id", h.deleteWebhook, adminAccount)
+	webhooks.GET("/:id/deliveries", h.getWebhookDeliveries, adminAccount)
 }
@@ -1,9 +1,12 @@
 package handlers
 
 import (
+	"TaskManager/internal/domain/model"
 	"errors"
+	"fmt"
 	"github.com/labstack/echo/v4"
 	"net/http"
+	"slices"
 	"strings"
 )
 
@@ -13,6 +16,7 @@ const (
 const (
 	authorizationHeader = "Authorization"
 	userCtx             = "userId"
+	scopesCtx           = "scopes"
 )
 
 func (h *Handler) userIdentityMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
@@ -29,17 +33,46 @@ func (h *Handler) userIdentityMiddleware(next echo.HandlerFunc) echo.HandlerFunc
 			return nil
 		}
 
-		userId, err := h.services.Authorization.ParseToken(headerParts[1])
+		userId, scopes, err := h.authenticate(headerParts[1])
 		if err != nil {
 			newErrorResponse(c, h.logger, http.StatusUnauthorized, "Invalid authorization token")
 			return nil
 		}
 
 		c.Set(userCtx, userId)
+		c.Set(scopesCtx, scopes)
 		return next(c)
 	}
 }
 
+// authenticate returns the user a bearer token belongs to and the scopes it grants. It accepts
+// personal access tokens, recognised by their prefix, as well as the JWTs issued by login.
+func (h *Handler) authenticate(token string) (string, []string, error) {
+	if strings.HasPrefix(token, model.AccessTokenPrefix) {
+		accessToken, err := h.services.AccessToken.Authenticate(token)
+		if err != nil {
+			return "", nil, err
+		}
+		return accessToken.UserId, accessToken.Scopes, nil
+	}
+	return h.services.Authorization.ParseTokenScopes(token)
+}
+
+// requireScope rejects requests whose token was not granted the scope. It runs after one of the
+// identity middlewares, which store the scopes of the token in the context.
+func (h *Handler) requireScope(scope string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if !slices.Contains(getScopes(c), scope) {
+				c.Response().Header().Set(echo.HeaderWWWAuthenticate, fmt.Sprintf(`Bearer error="insufficient_scope", scope="%s"`, scope))
+				newErrorResponse(c, h.logger, http.StatusForbidden, "token is missing the required scope "+scope)
+				return nil
+			}
+			return next(c)
+		}
+	}
+}
+
 func getUserId(c echo.Context) (string, error) {
 	id := c.Get(userCtx)
 	if id == nil {
@@ -53,3 +86,8 @@ func getUserId(c echo.Context) (string, error) {
 
 	return idStr, nil
 }
+
+func getScopes(c echo.Context) []string {
+	scopes, _ := c.Get(scopesCtx).([]string)
+	return scopes
+}
@@ -0,0 +1,99 @@
+package handlers
+
+import (
+	"TaskManager/internal/service"
+	"errors"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	oidcLoginCookie = "oidc_login"
+	oidcCookiePath  = "/auth/oidc"
+	oidcLoginTTL    = 10 * time.Minute
+)
+
+// oidcLogin redirects to the identity provider. The state, nonce and PKCE verifier are kept in a cookie
+// that only the callback receives.
+func (h *Handler) oidcLogin(e echo.Context) error {
+	log := h.logger.With(
+		zap.String("handler", "oidcLogin"),
+	)
+
+	if h.services.OIDC == nil {
+		newErrorResponse(e, log, http.StatusNotFound, "OIDC login is not configured")
+		return nil
+	}
+
+	url, login, err := h.services.OIDC.StartLogin()
+	if err != nil {
+		newErrorResponse(e, log, http.StatusInternalServerError, err.Error())
+		return nil
+	}
+
+	e.SetCookie(&http.Cookie{
+		Name:     oidcLoginCookie,
+		Value:    strings.Join([]string{login.State, login.Nonce, login.Verifier}, "."),
+		Path:     oidcCookiePath,
+		MaxAge:   int(oidcLoginTTL.Seconds()),
+		HttpOnly: true,
+		Secure:   e.IsTLS(),
+		SameSite: http.SameSiteLaxMode,
+	})
+	return e.Redirect(http.StatusFound, url)
+}
+
+// oidcCallback completes the sign-in and responds with a TaskManager token, like login.
+func (h *Handler) oidcCallback(e echo.Context) error {
+	log := h.logger.With(
+		zap.String("handler", "oidcCallback"),
+	)
+
+	if h.services.OIDC == nil {
+		newErrorResponse(e, log, http.StatusNotFound, "OIDC login is not configured")
+		return nil
+	}
+	if providerErr := e.QueryParam("error"); providerErr != isEmptyString {
+		newErrorResponse(e, log, http.StatusUnauthorized, "identity provider returned "+providerErr+": "+e.QueryParam("error_description"))
+		return nil
+	}
+
+	cookie, err := e.Cookie(oidcLoginCookie)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusBadRequest, "OIDC login was not started or has expired")
+		return nil
+	}
+	// The values are single-use.
+	e.SetCookie(&http.Cookie{Name: oidcLoginCookie, Path: oidcCookiePath, MaxAge: -1, HttpOnly: true, Secure: e.IsTLS()})
+
+	parts := strings.Split(cookie.Value, ".")
+	if len(parts) != 3 {
+		newErrorResponse(e, log, http.StatusBadRequest, "invalid OIDC login cookie")
+		return nil
+	}
+	login := service.OIDCLogin{State: parts[0], Nonce: parts[1], Verifier: parts[2]}
+
+	token, err := h.services.OIDC.FinishLogin(e.Request().Context(), login, e.QueryParam("state"), e.QueryParam("code"))
+	var challenge *service.TwoFactorChallenge
+	if errors.As(err, &challenge) {
+		log.Info("OIDC login awaits the second factor")
+		return twoFactorRequired(e, challenge)
+	}
+	if errors.Is(err, service.ErrOIDCNotLinked) {
+		newErrorResponse(e, log, http.StatusForbidden, err.Error())
+		return nil
+	}
+	if err != nil {
+		newErrorResponse(e, log, http.StatusUnauthorized, err.Error())
+		return nil
+	}
+
+	log.Info("User logged in through OIDC")
+
+	return e.JSON(http.StatusOK, map[string]interface{}{
+		"token": token,
+	})
+}
@@ -0,0 +1,247 @@
+package handlers
+
+import (
+	"TaskManager/internal/domain/model"
+	"TaskManager/internal/repository"
+	"TaskManager/internal/service"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/coreos/go-oidc/v3/oidc/oidctest"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.uber.org/zap"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+)
+
+// mockProvider is an OpenID Connect provider: oidctest serves discovery and keys,
+// and the token endpoint answers for the codes the test issues.
+type mockProvider struct {
+	*httptest.Server
+	key *rsa.PrivateKey
+
+	mu    sync.Mutex
+	codes map[string]mockGrant
+}
+
+// mockGrant is what the provider knows about an authorization code.
+type mockGrant struct {
+	subject   string
+	email     string
+	nonce     string
+	challenge string
+}
+
+func newMockProvider(t *testing.T) *mockProvider {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := &mockProvider{key: key, codes: map[string]mockGrant{}}
+	discovery := &oidctest.Server{PublicKeys: []oidctest.PublicKey{{PublicKey: key.Public(), KeyID: "idp-key", Algorithm: oidc.RS256}}}
+
+	mux := http.NewServeMux()
+	mux.Handle("/", discovery)
+	mux.HandleFunc("POST /token", p.token)
+	p.Server = httptest.NewServer(mux)
+	discovery.SetIssuer(p.URL)
+	t.Cleanup(p.Close)
+	return p
+}
+
+// authorize plays the user approving the login at the provider and returns the code.
+func (p *mockProvider) authorize(t *testing.T, authURL, subject, email string) string {
+	t.Helper()
+	query, err := url.Parse(authURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	params := query.Query()
+	if params.Get("code_challenge_method") != "S256" {
+		t.Fatalf("authorization URL %s does not use PKCE", authURL)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	code := fmt.Sprintf("code-%d", len(p.codes))
+	p.codes[code] = mockGrant{subject: subject, email: email, nonce: params.Get("nonce"), challenge: params.Get("code_challenge")}
+	return code
+}
+
+func (p *mockProvider) token(w http.ResponseWriter, r *http.Request) {
+	p.mu.Lock()
+	grant, ok := p.codes[r.FormValue("code")]
+	delete(p.codes, r.FormValue("code"))
+	p.mu.Unlock()
+
+	verifier := sha256.Sum256([]byte(r.FormValue("code_verifier")))
+	if !ok || base64.RawURLEncoding.EncodeToString(verifier[:]) != grant.challenge {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"error":"invalid_grant"}`))
+		return
+	}
+
+	claims, _ := json.Marshal(map[string]interface{}{
+		"iss":            p.URL,
+		"aud":            "taskmanager",
+		"sub":            grant.subject,
+		"exp":            time.Now().Add(time.Hour).Unix(),
+		"iat":            time.Now().Unix(),
+		"nonce":          grant.nonce,
+		"email":          grant.email,
+		"email_verified": true,
+	})
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"access_token": "provider-access-token",
+		"token_type":   "Bearer",
+		"expires_in":   3600,
+		"id_token":     oidctest.SignIDToken(p.key, "idp-key", oidc.RS256, string(claims)),
+	})
+}
+
+// memoryUsers stores users for the OIDC flow.
+type memoryUsers struct {
+	repository.Authorization
+	mu    sync.Mutex
+	users []model.User
+}
+
+func (m *memoryUsers) find(match func(model.User) bool) (model.User, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, user := range m.users {
+		if match(user) {
+			return user, nil
+		}
+	}
+	return model.User{}, repository.ErrUserNotFound
+}
+
+func (m *memoryUsers) GetUserByIdentity(identity model.ExternalIdentity) (model.User, error) {
+	return m.find(func(user model.User) bool {
+		return len(user.Identities) > 0 && user.Identities[0] == identity
+	})
+}
+
+func (m *memoryUsers) GetUserById(id string) (model.User, error) {
+	return m.find(func(user model.User) bool { return user.Id.Hex() == id })
+}
+
+func (m *memoryUsers) GetUserByEmail(email string) (model.User, error) {
+	return m.find(func(user model.User) bool { return user.Email == email })
+}
+
+func (m *memoryUsers) GetUserByUsername(username string) (model.User, error) {
+	return m.find(func(user model.User) bool { return user.Username == username })
+}
+
+func (m *memoryUsers) CreateUser(user model.User) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	user.Id = bson.NewObjectID()
+	m.users = append(m.users, user)
+	return len(m.users), nil
+}
+
+func TestOIDCLogin(t *testing.T) {
+	provider := newMockProvider(t)
+	users := &memoryUsers{}
+	keys, err := service.NewTokenKeys(service.TokenSettings{Secret: "test"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	oidcService, err := service.NewOIDCService(context.Background(), service.OIDCSettings{
+		Issuer:      provider.URL,
+		ClientID:    "taskmanager",
+		RedirectURL: "http://taskmanager.test/auth/oidc/callback",
+		CreateUsers: true,
+	}, users, keys)
+	if err != nil {
+		t.Fatalf("NewOIDCService() error = %v", err)
+	}
+	services := &service.Service{Authorization: service.NewAuthService(users, keys), OIDC: oidcService}
+	e := NewHandler(services, zap.NewNop(), Deprecation{}).InitRoutes(zap.NewNop())
+
+	// start begins a login and returns the provider URL and the cookie the browser keeps.
+	start := func() (string, *http.Cookie) {
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/auth/oidc/login", nil))
+		if rec.Code != http.StatusFound {
+			t.Fatalf("login status = %d, want %d", rec.Code, http.StatusFound)
+		}
+		cookies := rec.Result().Cookies()
+		if len(cookies) != 1 || !cookies[0].HttpOnly {
+			t.Fatalf("login cookies = %v, want one HttpOnly cookie", cookies)
+		}
+		return rec.Header().Get("Location"), cookies[0]
+	}
+	callback := func(cookie *http.Cookie, state, code string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/auth/oidc/callback?"+url.Values{"state": {state}, "code": {code}}.Encode(), nil)
+		req.AddCookie(cookie)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+	userOf := func(rec *httptest.ResponseRecorder) string {
+		t.Helper()
+		var resp struct {
+			Token string `json:"token"`
+		}
+		if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+			t.Fatalf("callback body %q: %v", rec.Body.String(), err)
+		}
+		userId, err := services.Authorization.ParseToken(resp.Token)
+		if err != nil {
+			t.Fatalf("callback token is not a TaskManager token: %v", err)
+		}
+		return userId
+	}
+
+	authURL, cookie := start()
+	state, _ := url.Parse(authURL)
+	code := provider.authorize(t, authURL, "idp-123", "jane.doe@example.com")
+	rec := callback(cookie, state.Query().Get("state"), code)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("callback status = %d, body %s", rec.Code, rec.Body)
+	}
+	firstUser := userOf(rec)
+	if len(users.users) != 1 || users.users[0].Username != "janedoe" || users.users[0].Id.Hex() != firstUser {
+		t.Fatalf("users after first login = %+v", users.users)
+	}
+
+	// Signing in again with the same identity reaches the same user.
+	authURL, cookie = start()
+	state, _ = url.Parse(authURL)
+	code = provider.authorize(t, authURL, "idp-123", "jane.doe@example.com")
+	if rec := callback(cookie, state.Query().Get("state"), code); rec.Code != http.StatusOK || userOf(rec) != firstUser || len(users.users) != 1 {
+		t.Errorf("second login: status %d, %d users", rec.Code, len(users.users))
+	}
+
+	// A callback whose state does not match the cookie is rejected.
+	authURL, cookie = start()
+	code = provider.authorize(t, authURL, "idp-123", "jane.doe@example.com")
+	if rec := callback(cookie, "forged", code); rec.Code != http.StatusUnauthorized {
+		t.Errorf("forged state status = %d, want %d", rec.Code, http.StatusUnauthorized)
+	}
+}
+
+func TestOIDCLogin_NotConfigured(t *testing.T) {
+	e := NewHandler(&service.Service{}, zap.NewNop(), Deprecation{}).InitRoutes(zap.NewNop())
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/auth/oidc/login", nil))
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+}
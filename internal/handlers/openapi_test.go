@@ -0,0 +1,108 @@
+package handlers
+
+import (
+	"TaskManager/internal/service"
+	"encoding/json"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// undocumentedRoutes are served by the server but are not part of the REST API.
+// The GraphQL endpoint describes itself through introspection.
+var undocumentedRoutes = map[string]bool{
+	"GET /openapi.json":          true,
+	"GET /docs":                  true,
+	"POST /graphql":              true,
+	"GET /.well-known/jwks.json": true,
+	"GET /auth/oidc/login":       true,
+	"GET /auth/oidc/callback":    true,
+}
+
+var pathParam = regexp.MustCompile(`:(\w+)`)
+
+func TestOpenAPISpecMatchesRoutes(t *testing.T) {
+	e := NewHandler(&service.Service{}, zap.NewNop(), Deprecation{}).InitRoutes(zap.NewNop())
+
+	// The spec describes v1 relative to its server URL; the legacy aliases must mirror v1 exactly.
+	routes, legacy := map[string]bool{}, map[string]bool{}
+	for _, route := range e.Routes() {
+		// Groups register catch-all routes that answer 404.
+		if route.Method == echo.RouteNotFound {
+			continue
+		}
+		key := route.Method + " " + pathParam.ReplaceAllString(route.Path, "{$1}")
+		switch {
+		case undocumentedRoutes[key]:
+		case strings.HasPrefix(route.Path, apiV1Prefix+"/"):
+			routes[route.Method+" "+strings.TrimPrefix(key, route.Method+" "+apiV1Prefix)] = true
+		default:
+			legacy[key] = true
+		}
+	}
+	if missing := difference(routes, legacy); len(missing) > 0 {
+		t.Errorf("v1 routes without a legacy alias:\n  %s", strings.Join(missing, "\n  "))
+	}
+	if extra := difference(legacy, routes); len(extra) > 0 {
+		t.Errorf("legacy routes without a v1 route:\n  %s", strings.Join(extra, "\n  "))
+	}
+
+	var spec struct {
+		Servers []struct {
+			URL string `json:"url"`
+		} `json:"servers"`
+		Paths map[string]map[string]json.RawMessage `json:"paths"`
+	}
+	if err := json.Unmarshal(openAPISpec, &spec); err != nil {
+		t.Fatalf("openapi.json is not valid JSON: %v", err)
+	}
+	if len(spec.Servers) != 1 || spec.Servers[0].URL != apiV1Prefix {
+		t.Errorf("openapi.json servers = %v, want %s", spec.Servers, apiV1Prefix)
+	}
+	documented := map[string]bool{}
+	for path, operations := range spec.Paths {
+		for method := range operations {
+			documented[strings.ToUpper(method)+" "+path] = true
+		}
+	}
+
+	if missing := difference(routes, documented); len(missing) > 0 {
+		t.Errorf("routes missing from openapi.json:\n  %s", strings.Join(missing, "\n  "))
+	}
+	if stale := difference(documented, routes); len(stale) > 0 {
+		t.Errorf("openapi.json documents routes that are not registered:\n  %s", strings.Join(stale, "\n  "))
+	}
+}
+
+func TestOpenAPISpecReferencesResolve(t *testing.T) {
+	var spec struct {
+		Components struct {
+			Schemas map[string]json.RawMessage `json:"schemas"`
+		} `json:"components"`
+	}
+	if err := json.Unmarshal(openAPISpec, &spec); err != nil {
+		t.Fatalf("openapi.json is not valid JSON: %v", err)
+	}
+
+	refs := regexp.MustCompile(`"\$ref":\s*"#/components/schemas/(\w+)"`).FindAllStringSubmatch(string(openAPISpec), -1)
+	for _, ref := range refs {
+		if _, ok := spec.Components.Schemas[ref[1]]; !ok {
+			t.Errorf("schema %s is referenced but not defined", ref[1])
+		}
+	}
+}
+
+// difference returns the sorted keys of a that are not in b.
+func difference(a, b map[string]bool) []string {
+	var keys []string
+	for key := range a {
+		if !b[key] {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,74 @@
+package handlers
+
+import (
+	"TaskManager/internal/domain/model"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+	"net/http"
+	"strconv"
+)
+
+// setRecurrence sets or edits the recurrence rule of the series a task belongs to.
+func (h *Handler) setRecurrence(e echo.Context) error {
+	log := h.logger.With(
+		zap.String("handler", "setRecurrence"),
+	)
+
+	userId, err := getUserId(e)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusUnauthorized, err.Error())
+		return nil
+	}
+
+	taskId, err := strconv.Atoi(e.Param("id"))
+	if taskId == 0 {
+		newErrorResponse(e, log, http.StatusBadRequest, "task ID is required")
+		return nil
+	}
+
+	var input model.RecurrenceInput
+	if err := e.Bind(&input); err != nil {
+		newErrorResponse(e, log, http.StatusBadRequest, err.Error())
+		return nil
+	}
+	log.Info("setting task recurrence", zap.Int("task_id", taskId), zap.String("rrule", input.RRule))
+
+	if err := h.services.Recurrence.SetRecurrence(userId, taskId, input.RRule); err != nil {
+		newErrorResponse(e, log, http.StatusInternalServerError, err.Error())
+		return nil
+	}
+
+	log.Info("task recurrence set successfully", zap.Int("task_id", taskId))
+	return e.JSON(http.StatusOK, statusResponse{
+		Status: "Recurrence updated successfully",
+	})
+}
+
+// stopRecurrence stops the recurrence series a task belongs to.
+func (h *Handler) stopRecurrence(e echo.Context) error {
+	log := h.logger.With(
+		zap.String("handler", "stopRecurrence"),
+	)
+
+	userId, err := getUserId(e)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusUnauthorized, err.Error())
+		return nil
+	}
+
+	taskId, err := strconv.Atoi(e.Param("id"))
+	if taskId == 0 {
+		newErrorResponse(e, log, http.StatusBadRequest, "task ID is required")
+		return nil
+	}
+
+	if err := h.services.Recurrence.StopRecurrence(userId, taskId); err != nil {
+		newErrorResponse(e, log, http.StatusInternalServerError, err.Error())
+		return nil
+	}
+
+	log.Info("task recurrence stopped successfully", zap.Int("task_id", taskId))
+	return e.JSON(http.StatusOK, statusResponse{
+		Status: "Recurrence stopped successfully",
+	})
+}
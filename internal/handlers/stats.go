@@ -0,0 +1,40 @@
+package handlers
+
+import (
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+	"net/http"
+	"strconv"
+)
+
+// weeksParam is the number of ISO weeks covered by the weekly statistics.
+const weeksParam = "weeks"
+
+// getStats retrieves the productivity statistics of the user.
+func (h *Handler) getStats(e echo.Context) error {
+	log := h.logger.With(
+		zap.String("handler", "getStats"),
+	)
+
+	userId, err := getUserId(e)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusUnauthorized, err.Error())
+		return nil
+	}
+
+	var weeks int
+	if value := e.QueryParam(weeksParam); value != isEmptyString {
+		if weeks, err = strconv.Atoi(value); err != nil || weeks < 1 {
+			newErrorResponse(e, log, http.StatusBadRequest, "weeks must be a positive integer")
+			return nil
+		}
+	}
+
+	stats, err := h.services.Stats.GetStats(userId, weeks)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusInternalServerError, err.Error())
+		return nil
+	}
+
+	return e.JSON(http.StatusOK, stats)
+}
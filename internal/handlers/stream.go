@@ -0,0 +1,216 @@
+package handlers
+
+import (
+	"TaskManager/internal/domain/model"
+	"encoding/json"
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+	"golang.org/x/net/websocket"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	lastEventIdHeader = "Last-Event-ID"
+	lastEventIdParam  = "last_event_id"
+	streamTicketParam = "ticket"
+	streamHeartbeat   = 30 * time.Second
+)
+
+// streamIdentityMiddleware lets clients that cannot set headers, such as EventSource and browser WebSockets,
+// identify themselves with a stream ticket in the ticket query parameter. Bearer tokens are only accepted
+// in the Authorization header, so they never end up in access logs.
+func (h *Handler) streamIdentityMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ticket := c.QueryParam(streamTicketParam)
+		if c.Request().Header.Get(authorizationHeader) != isEmptyString || ticket == isEmptyString {
+			return h.userIdentityMiddleware(next)(c)
+		}
+
+		userId, scopes, err := h.services.StreamTicket.ParseStreamTicket(ticket)
+		if err != nil {
+			newErrorResponse(c, h.logger, http.StatusUnauthorized, err.Error())
+			return nil
+		}
+		c.Set(userCtx, userId)
+		c.Set(scopesCtx, scopes)
+		return next(c)
+	}
+}
+
+// createStreamTicket issues a short-lived ticket that opens the task event stream.
+func (h *Handler) createStreamTicket(e echo.Context) error {
+	log := h.logger.With(
+		zap.String("handler", "createStreamTicket"),
+	)
+
+	userId, err := getUserId(e)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusUnauthorized, err.Error())
+		return nil
+	}
+
+	ticket, expiresAt, err := h.services.StreamTicket.IssueStreamTicket(userId, getScopes(e))
+	if err != nil {
+		newErrorResponse(e, log, http.StatusInternalServerError, err.Error())
+		return nil
+	}
+
+	return e.JSON(http.StatusOK, map[string]interface{}{
+		"ticket":     ticket,
+		"expires_at": expiresAt,
+	})
+}
+
+// streamTasks pushes the user's task list events as Server-Sent Events.
+// Clients resume with the Last-Event-ID header or the last_event_id query parameter.
+func (h *Handler) streamTasks(e echo.Context) error {
+	log := h.logger.With(
+		zap.String("handler", "streamTasks"),
+	)
+
+	userId, err := getUserId(e)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusUnauthorized, err.Error())
+		return nil
+	}
+
+	lastEventId, err := parseLastEventId(e)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusBadRequest, err.Error())
+		return nil
+	}
+
+	backlog, sub := h.services.Events.Subscribe(userId, lastEventId)
+	defer sub.Close()
+	log.Info("event stream opened", zap.String("user_id", userId), zap.Int64("last_event_id", lastEventId))
+
+	w := e.Response()
+	w.Header().Set(echo.HeaderContentType, "text/event-stream")
+	w.Header().Set(echo.HeaderCacheControl, "no-cache")
+	w.Header().Set(echo.HeaderConnection, "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	w.Flush()
+
+	for _, event := range backlog {
+		if err := writeServerSentEvent(w, event); err != nil {
+			return nil
+		}
+	}
+
+	heartbeat := time.NewTicker(streamHeartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-e.Request().Context().Done():
+			log.Info("event stream closed", zap.String("user_id", userId))
+			return nil
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(w, ": ping\n\n"); err != nil {
+				return nil
+			}
+			w.Flush()
+		case event, ok := <-sub.C:
+			if !ok {
+				log.Info("event stream dropped", zap.String("user_id", userId))
+				return nil
+			}
+			if err := writeServerSentEvent(w, event); err != nil {
+				return nil
+			}
+		}
+	}
+}
+
+// streamTasksWebSocket pushes the user's task list events as JSON WebSocket messages.
+// Clients resume with the last_event_id query parameter.
+func (h *Handler) streamTasksWebSocket(e echo.Context) error {
+	log := h.logger.With(
+		zap.String("handler", "streamTasksWebSocket"),
+	)
+
+	userId, err := getUserId(e)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusUnauthorized, err.Error())
+		return nil
+	}
+
+	lastEventId, err := parseLastEventId(e)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusBadRequest, err.Error())
+		return nil
+	}
+
+	websocket.Handler(func(ws *websocket.Conn) {
+		defer ws.Close()
+
+		backlog, sub := h.services.Events.Subscribe(userId, lastEventId)
+		defer sub.Close()
+		log.Info("websocket stream opened", zap.String("user_id", userId), zap.Int64("last_event_id", lastEventId))
+
+		// The stream is push-only; reading detects when the client goes away.
+		closed := make(chan struct{})
+		go func() {
+			defer close(closed)
+			var discard string
+			for websocket.Message.Receive(ws, &discard) == nil {
+			}
+		}()
+
+		for _, event := range backlog {
+			if err := websocket.JSON.Send(ws, event); err != nil {
+				return
+			}
+		}
+
+		for {
+			select {
+			case <-closed:
+				log.Info("websocket stream closed", zap.String("user_id", userId))
+				return
+			case event, ok := <-sub.C:
+				if !ok {
+					log.Info("websocket stream dropped", zap.String("user_id", userId))
+					return
+				}
+				if err := websocket.JSON.Send(ws, event); err != nil {
+					return
+				}
+			}
+		}
+	}).ServeHTTP(e.Response(), e.Request())
+	return nil
+}
+
+// parseLastEventId reads the ID of the last event the client received, or 0 when it is not resuming.
+func parseLastEventId(e echo.Context) (int64, error) {
+	value := e.Request().Header.Get(lastEventIdHeader)
+	if value == isEmptyString {
+		value = e.QueryParam(lastEventIdParam)
+	}
+	if value == isEmptyString {
+		return 0, nil
+	}
+
+	id, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || id < 0 {
+		return 0, fmt.Errorf("invalid last event ID %q", value)
+	}
+	return id, nil
+}
+
+// writeServerSentEvent writes a single event in the text/event-stream format and flushes it.
+func writeServerSentEvent(w *echo.Response, event model.Event) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", event.Id, event.Type, data); err != nil {
+		return err
+	}
+	w.Flush()
+	return nil
+}
@@ -0,0 +1,67 @@
+package handlers
+
+import (
+	"TaskManager/internal/service"
+	"errors"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type fakeStreamTickets struct {
+	service.StreamTicket
+}
+
+func (fakeStreamTickets) ParseStreamTicket(ticket string) (string, []string, error) {
+	if ticket != "ticket-1" {
+		return "", nil, errors.New("invalid or expired stream ticket")
+	}
+	return "ticket-user", nil, nil
+}
+
+func (fakeStreamTickets) IssueStreamTicket(userId string, scopes []string) (string, time.Time, error) {
+	return "ticket-1", time.Now(), nil
+}
+
+func TestStreamIdentityMiddleware(t *testing.T) {
+	h := NewHandler(&service.Service{Authorization: fakeJWTs{}, StreamTicket: fakeStreamTickets{}}, zap.NewNop(), Deprecation{})
+
+	testTable := []struct {
+		name       string
+		query      string
+		header     string
+		wantStatus int
+		wantUser   string
+	}{
+		{name: "Ticket", query: "?ticket=ticket-1", wantStatus: http.StatusOK, wantUser: "ticket-user"},
+		{name: "Header", header: "Bearer jwt", wantStatus: http.StatusOK, wantUser: "jwt-user"},
+		{name: "Expired Ticket", query: "?ticket=old", wantStatus: http.StatusUnauthorized},
+		{name: "Token In Query", query: "?access_token=jwt", wantStatus: http.StatusUnauthorized},
+	}
+
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/tasks/stream"+tt.query, nil)
+			if tt.header != "" {
+				req.Header.Set(authorizationHeader, tt.header)
+			}
+			rec := httptest.NewRecorder()
+			c := echo.New().NewContext(req, rec)
+
+			var gotUser string
+			err := h.streamIdentityMiddleware(func(c echo.Context) error {
+				gotUser, _ = getUserId(c)
+				return c.NoContent(http.StatusOK)
+			})(c)
+			if err != nil {
+				t.Fatalf("middleware error = %v", err)
+			}
+			if rec.Code != tt.wantStatus || gotUser != tt.wantUser {
+				t.Errorf("status = %d, user = %q; want %d, %q", rec.Code, gotUser, tt.wantStatus, tt.wantUser)
+			}
+		})
+	}
+}
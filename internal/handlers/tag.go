@@ -0,0 +1,130 @@
+package handlers
+
+import (
+	"TaskManager/internal/domain/model"
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+const (
+	tagsParam      = "tags"
+	tagsMatchParam = "tags_match"
+	tagsMatchAny   = "any"
+	tagsMatchAll   = "all"
+)
+
+// addTags adds tags to a task.
+func (h *Handler) addTags(e echo.Context) error {
+	log := h.logger.With(
+		zap.String("handler", "addTags"),
+	)
+
+	userId, err := getUserId(e)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusUnauthorized, err.Error())
+		return nil
+	}
+
+	taskId, err := strconv.Atoi(e.Param("id"))
+	if taskId == 0 {
+		newErrorResponse(e, log, http.StatusBadRequest, "task ID is required")
+		return nil
+	}
+
+	var input model.TagsInput
+	if err := e.Bind(&input); err != nil {
+		newErrorResponse(e, log, http.StatusBadRequest, err.Error())
+		return nil
+	}
+	log.Info("adding tags to task", zap.Int("task_id", taskId), zap.Strings("tags", input.Tags))
+
+	if err := h.services.TaskList.AddTags(userId, taskId, input.Tags); err != nil {
+		newErrorResponse(e, log, http.StatusInternalServerError, err.Error())
+		return nil
+	}
+
+	log.Info("tags added successfully", zap.Int("task_id", taskId))
+	return e.JSON(http.StatusOK, statusResponse{
+		Status: "Tags added successfully",
+	})
+}
+
+// removeTag removes a tag from a task.
+func (h *Handler) removeTag(e echo.Context) error {
+	log := h.logger.With(
+		zap.String("handler", "removeTag"),
+	)
+
+	userId, err := getUserId(e)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusUnauthorized, err.Error())
+		return nil
+	}
+
+	taskId, err := strconv.Atoi(e.Param("id"))
+	if taskId == 0 {
+		newErrorResponse(e, log, http.StatusBadRequest, "task ID is required")
+		return nil
+	}
+
+	tag := e.Param("tag")
+	if err := h.services.TaskList.RemoveTag(userId, taskId, tag); err != nil {
+		newErrorResponse(e, log, http.StatusInternalServerError, err.Error())
+		return nil
+	}
+
+	log.Info("tag removed successfully", zap.Int("task_id", taskId), zap.String("tag", tag))
+	return e.JSON(http.StatusOK, statusResponse{
+		Status: "Tag removed successfully",
+	})
+}
+
+// getTagsResponse is the response structure for retrieving a user's tags.
+type getTagsResponse struct {
+	Data []model.TagCount `json:"data"`
+}
+
+// getTags retrieves the user's tags with the number of tasks carrying each.
+func (h *Handler) getTags(e echo.Context) error {
+	log := h.logger.With(
+		zap.String("handler", "getTags"),
+	)
+
+	userId, err := getUserId(e)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusUnauthorized, err.Error())
+		return nil
+	}
+
+	tags, err := h.services.TaskList.GetTags(userId)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusInternalServerError, err.Error())
+		return nil
+	}
+
+	log.Info("tags retrieved successfully", zap.Int("tag_count", len(tags)))
+	return e.JSON(http.StatusOK, getTagsResponse{
+		Data: tags,
+	})
+}
+
+// parseTaskListFilter reads the tag filter from the tags (comma-separated) and tags_match (any or all) query parameters.
+func parseTaskListFilter(e echo.Context) (model.TaskListFilter, error) {
+	var filter model.TaskListFilter
+	if value := e.QueryParam(tagsParam); value != isEmptyString {
+		filter.Tags = strings.Split(value, ",")
+	}
+
+	switch match := e.QueryParam(tagsMatchParam); match {
+	case isEmptyString, tagsMatchAny:
+	case tagsMatchAll:
+		filter.MatchAllTags = true
+	default:
+		return model.TaskListFilter{}, fmt.Errorf("tags_match must be %q or %q", tagsMatchAny, tagsMatchAll)
+	}
+	return filter, nil
+}
@@ -0,0 +1,111 @@
+package handlers
+
+import (
+	"TaskManager/internal/domain/model"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+	"net/http"
+	"strconv"
+)
+
+// addTaskItem adds a subtask to a task.
+func (h *Handler) addTaskItem(e echo.Context) error {
+	log := h.logger.With(
+		zap.String("handler", "addTaskItem"),
+	)
+
+	userId, err := getUserId(e)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusUnauthorized, err.Error())
+		return nil
+	}
+
+	taskId, err := strconv.Atoi(e.Param("id"))
+	if taskId == 0 {
+		newErrorResponse(e, log, http.StatusBadRequest, "task ID is required")
+		return nil
+	}
+
+	var input model.TaskItemInput
+	if err := e.Bind(&input); err != nil {
+		newErrorResponse(e, log, http.StatusBadRequest, err.Error())
+		return nil
+	}
+
+	id, err := h.services.TaskItem.AddItem(userId, taskId, input)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusInternalServerError, err.Error())
+		return nil
+	}
+
+	log.Info("item added successfully", zap.Int("task_id", taskId), zap.String("item_id", id))
+	return e.JSON(http.StatusOK, map[string]interface{}{
+		"id": id,
+	})
+}
+
+// updateTaskItem updates a subtask of a task.
+func (h *Handler) updateTaskItem(e echo.Context) error {
+	log := h.logger.With(
+		zap.String("handler", "updateTaskItem"),
+	)
+
+	userId, err := getUserId(e)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusUnauthorized, err.Error())
+		return nil
+	}
+
+	taskId, err := strconv.Atoi(e.Param("id"))
+	if taskId == 0 {
+		newErrorResponse(e, log, http.StatusBadRequest, "task ID is required")
+		return nil
+	}
+
+	var input model.UpdateTaskItemInput
+	if err := e.Bind(&input); err != nil {
+		newErrorResponse(e, log, http.StatusBadRequest, err.Error())
+		return nil
+	}
+
+	itemId := e.Param("itemId")
+	if err := h.services.TaskItem.UpdateItem(userId, taskId, itemId, input); err != nil {
+		newErrorResponse(e, log, http.StatusInternalServerError, err.Error())
+		return nil
+	}
+
+	log.Info("item updated successfully", zap.Int("task_id", taskId), zap.String("item_id", itemId))
+	return e.JSON(http.StatusOK, statusResponse{
+		Status: "Item updated successfully",
+	})
+}
+
+// deleteTaskItem removes a subtask from a task.
+func (h *Handler) deleteTaskItem(e echo.Context) error {
+	log := h.logger.With(
+		zap.String("handler", "deleteTaskItem"),
+	)
+
+	userId, err := getUserId(e)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusUnauthorized, err.Error())
+		return nil
+	}
+
+	taskId, err := strconv.Atoi(e.Param("id"))
+	if taskId == 0 {
+		newErrorResponse(e, log, http.StatusBadRequest, "task ID is required")
+		return nil
+	}
+
+	itemId := e.Param("itemId")
+	if err := h.services.TaskItem.DeleteItem(userId, taskId, itemId); err != nil {
+		newErrorResponse(e, log, http.StatusInternalServerError, err.Error())
+		return nil
+	}
+
+	log.Info("item deleted successfully", zap.Int("task_id", taskId), zap.String("item_id", itemId))
+	return e.JSON(http.StatusOK, statusResponse{
+		Status: "Item deleted successfully",
+	})
+}
@@ -45,7 +45,7 @@ type getAllTasksResponse struct {
 	Data []model.TaskList `json:"data"`
 }
 
-// getTask retrieves a list of tasks.
+// getTask retrieves a list of tasks, optionally filtered by tags.
 func (h *Handler) getTasks(e echo.Context) error {
 	log := h.logger.With(
 		zap.String("handler", "getTasks"),
@@ -59,7 +59,13 @@ func (h *Handler) getTasks(e echo.Context) error {
 	}
 	log.Info("user ID retrieved successfully", zap.String("user_id", userId))
 
-	tasks, err := h.services.TaskList.GetAll(userId)
+	filter, err := parseTaskListFilter(e)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusBadRequest, err.Error())
+		return nil
+	}
+
+	tasks, err := h.services.TaskList.GetAll(userId, filter)
 	if err != nil {
 		newErrorResponse(e, log, http.StatusInternalServerError, err.Error())
 		return nil
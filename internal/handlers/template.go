@@ -0,0 +1,173 @@
+package handlers
+
+import (
+	"TaskManager/internal/domain/model"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+	"net/http"
+)
+
+// createTemplate saves a new task template for the user.
+func (h *Handler) createTemplate(e echo.Context) error {
+	log := h.logger.With(
+		zap.String("handler", "createTemplate"),
+	)
+
+	userId, err := getUserId(e)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusUnauthorized, err.Error())
+		return nil
+	}
+
+	var input model.TemplateInput
+	if err := e.Bind(&input); err != nil {
+		newErrorResponse(e, log, http.StatusBadRequest, err.Error())
+		return nil
+	}
+
+	id, err := h.services.Template.CreateTemplate(userId, input)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusInternalServerError, err.Error())
+		return nil
+	}
+
+	log.Info("template created successfully", zap.String("template_id", id))
+	return e.JSON(http.StatusOK, map[string]interface{}{
+		"id": id,
+	})
+}
+
+// getAllTemplatesResponse is the response structure for retrieving all templates.
+type getAllTemplatesResponse struct {
+	Data []model.Template `json:"data"`
+}
+
+// getTemplates retrieves the user's task templates.
+func (h *Handler) getTemplates(e echo.Context) error {
+	log := h.logger.With(
+		zap.String("handler", "getTemplates"),
+	)
+
+	userId, err := getUserId(e)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusUnauthorized, err.Error())
+		return nil
+	}
+
+	templates, err := h.services.Template.GetTemplates(userId)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusInternalServerError, err.Error())
+		return nil
+	}
+
+	log.Info("templates retrieved successfully", zap.Int("template_count", len(templates)))
+	return e.JSON(http.StatusOK, getAllTemplatesResponse{
+		Data: templates,
+	})
+}
+
+// getTemplateByID retrieves a specific task template.
+func (h *Handler) getTemplateByID(e echo.Context) error {
+	log := h.logger.With(
+		zap.String("handler", "getTemplateByID"),
+	)
+
+	userId, err := getUserId(e)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusUnauthorized, err.Error())
+		return nil
+	}
+
+	template, err := h.services.Template.GetTemplate(userId, e.Param("id"))
+	if err != nil {
+		newErrorResponse(e, log, http.StatusNotFound, err.Error())
+		return nil
+	}
+
+	return e.JSON(http.StatusOK, template)
+}
+
+// updateTemplate replaces the content of a task template.
+func (h *Handler) updateTemplate(e echo.Context) error {
+	log := h.logger.With(
+		zap.String("handler", "updateTemplate"),
+	)
+
+	userId, err := getUserId(e)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusUnauthorized, err.Error())
+		return nil
+	}
+
+	var input model.TemplateInput
+	if err := e.Bind(&input); err != nil {
+		newErrorResponse(e, log, http.StatusBadRequest, err.Error())
+		return nil
+	}
+
+	templateId := e.Param("id")
+	if err := h.services.Template.UpdateTemplate(userId, templateId, input); err != nil {
+		newErrorResponse(e, log, http.StatusInternalServerError, err.Error())
+		return nil
+	}
+
+	log.Info("template updated successfully", zap.String("template_id", templateId))
+	return e.JSON(http.StatusOK, statusResponse{
+		Status: "Template updated successfully",
+	})
+}
+
+// deleteTemplate deletes a task template.
+func (h *Handler) deleteTemplate(e echo.Context) error {
+	log := h.logger.With(
+		zap.String("handler", "deleteTemplate"),
+	)
+
+	userId, err := getUserId(e)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusUnauthorized, err.Error())
+		return nil
+	}
+
+	templateId := e.Param("id")
+	if err := h.services.Template.DeleteTemplate(userId, templateId); err != nil {
+		newErrorResponse(e, log, http.StatusInternalServerError, err.Error())
+		return nil
+	}
+
+	log.Info("template deleted successfully", zap.String("template_id", templateId))
+	return e.JSON(http.StatusOK, statusResponse{
+		Status: "Template deleted successfully",
+	})
+}
+
+// instantiateTemplate creates a new task for the user from a template.
+func (h *Handler) instantiateTemplate(e echo.Context) error {
+	log := h.logger.With(
+		zap.String("handler", "instantiateTemplate"),
+	)
+
+	userId, err := getUserId(e)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusUnauthorized, err.Error())
+		return nil
+	}
+
+	var input model.InstantiateTemplateInput
+	if err := e.Bind(&input); err != nil {
+		newErrorResponse(e, log, http.StatusBadRequest, err.Error())
+		return nil
+	}
+
+	templateId := e.Param("id")
+	id, err := h.services.Template.InstantiateTemplate(userId, templateId, input)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusInternalServerError, err.Error())
+		return nil
+	}
+
+	log.Info("template instantiated successfully", zap.String("template_id", templateId), zap.Int("task_id", id))
+	return e.JSON(http.StatusOK, map[string]interface{}{
+		"id": id,
+	})
+}
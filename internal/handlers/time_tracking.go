@@ -0,0 +1,281 @@
+package handlers
+
+import (
+	"TaskManager/internal/domain/model"
+	"encoding/csv"
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	reportFromParam    = "from"
+	reportToParam      = "to"
+	reportFormatParam  = "format"
+	reportFormatCSV    = "csv"
+	reportDateLayout   = "2006-01-02"
+	defaultReportDays  = 30
+	timeReportFilename = "time-report.csv"
+)
+
+// startTimer starts the user's timer on a task.
+func (h *Handler) startTimer(e echo.Context) error {
+	log := h.logger.With(
+		zap.String("handler", "startTimer"),
+	)
+
+	userId, err := getUserId(e)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusUnauthorized, err.Error())
+		return nil
+	}
+
+	taskId, err := strconv.Atoi(e.Param("id"))
+	if taskId == 0 {
+		newErrorResponse(e, log, http.StatusBadRequest, "task ID is required")
+		return nil
+	}
+
+	var input model.TimerInput
+	if err := e.Bind(&input); err != nil {
+		newErrorResponse(e, log, http.StatusBadRequest, err.Error())
+		return nil
+	}
+
+	id, err := h.services.TimeTracking.StartTimer(userId, taskId, input)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusInternalServerError, err.Error())
+		return nil
+	}
+
+	log.Info("timer started successfully", zap.Int("task_id", taskId), zap.String("entry_id", id))
+	return e.JSON(http.StatusOK, map[string]interface{}{
+		"id": id,
+	})
+}
+
+// stopTimer stops the user's running timer on a task and returns the finished entry.
+func (h *Handler) stopTimer(e echo.Context) error {
+	log := h.logger.With(
+		zap.String("handler", "stopTimer"),
+	)
+
+	userId, err := getUserId(e)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusUnauthorized, err.Error())
+		return nil
+	}
+
+	taskId, err := strconv.Atoi(e.Param("id"))
+	if taskId == 0 {
+		newErrorResponse(e, log, http.StatusBadRequest, "task ID is required")
+		return nil
+	}
+
+	entry, err := h.services.TimeTracking.StopTimer(userId, taskId)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusInternalServerError, err.Error())
+		return nil
+	}
+
+	log.Info("timer stopped successfully", zap.Int("task_id", taskId), zap.Int64("seconds", entry.Seconds))
+	return e.JSON(http.StatusOK, entry)
+}
+
+// addTimeEntry records time spent on a task by hand.
+func (h *Handler) addTimeEntry(e echo.Context) error {
+	log := h.logger.With(
+		zap.String("handler", "addTimeEntry"),
+	)
+
+	userId, err := getUserId(e)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusUnauthorized, err.Error())
+		return nil
+	}
+
+	taskId, err := strconv.Atoi(e.Param("id"))
+	if taskId == 0 {
+		newErrorResponse(e, log, http.StatusBadRequest, "task ID is required")
+		return nil
+	}
+
+	var input model.TimeEntryInput
+	if err := e.Bind(&input); err != nil {
+		newErrorResponse(e, log, http.StatusBadRequest, err.Error())
+		return nil
+	}
+
+	id, err := h.services.TimeTracking.AddTimeEntry(userId, taskId, input)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusInternalServerError, err.Error())
+		return nil
+	}
+
+	log.Info("time entry added successfully", zap.Int("task_id", taskId), zap.String("entry_id", id))
+	return e.JSON(http.StatusOK, map[string]interface{}{
+		"id": id,
+	})
+}
+
+// getAllTimeEntriesResponse is the response structure for retrieving the time entries of a task.
+type getAllTimeEntriesResponse struct {
+	Data []model.TimeEntry `json:"data"`
+}
+
+// getTimeEntries retrieves the time tracked on a task.
+func (h *Handler) getTimeEntries(e echo.Context) error {
+	log := h.logger.With(
+		zap.String("handler", "getTimeEntries"),
+	)
+
+	userId, err := getUserId(e)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusUnauthorized, err.Error())
+		return nil
+	}
+
+	taskId, err := strconv.Atoi(e.Param("id"))
+	if taskId == 0 {
+		newErrorResponse(e, log, http.StatusBadRequest, "task ID is required")
+		return nil
+	}
+
+	entries, err := h.services.TimeTracking.GetTimeEntries(userId, taskId)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusInternalServerError, err.Error())
+		return nil
+	}
+
+	log.Info("time entries retrieved successfully", zap.Int("task_id", taskId), zap.Int("entry_count", len(entries)))
+	return e.JSON(http.StatusOK, getAllTimeEntriesResponse{
+		Data: entries,
+	})
+}
+
+// deleteTimeEntry removes one of the user's time entries from a task.
+func (h *Handler) deleteTimeEntry(e echo.Context) error {
+	log := h.logger.With(
+		zap.String("handler", "deleteTimeEntry"),
+	)
+
+	userId, err := getUserId(e)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusUnauthorized, err.Error())
+		return nil
+	}
+
+	taskId, err := strconv.Atoi(e.Param("id"))
+	if taskId == 0 {
+		newErrorResponse(e, log, http.StatusBadRequest, "task ID is required")
+		return nil
+	}
+
+	entryId := e.Param("entryId")
+	if err := h.services.TimeTracking.DeleteTimeEntry(userId, taskId, entryId); err != nil {
+		newErrorResponse(e, log, http.StatusInternalServerError, err.Error())
+		return nil
+	}
+
+	log.Info("time entry deleted successfully", zap.Int("task_id", taskId), zap.String("entry_id", entryId))
+	return e.JSON(http.StatusOK, statusResponse{
+		Status: "Time entry deleted successfully",
+	})
+}
+
+// getTimeReport aggregates the user's tracked time by task, tag and day over the from and to dates (inclusive).
+// With format=csv the report is downloaded as a CSV file.
+func (h *Handler) getTimeReport(e echo.Context) error {
+	log := h.logger.With(
+		zap.String("handler", "getTimeReport"),
+	)
+
+	userId, err := getUserId(e)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusUnauthorized, err.Error())
+		return nil
+	}
+
+	from, to, err := parseReportRange(e, time.Now())
+	if err != nil {
+		newErrorResponse(e, log, http.StatusBadRequest, err.Error())
+		return nil
+	}
+
+	format := e.QueryParam(reportFormatParam)
+	if format != isEmptyString && format != reportFormatCSV {
+		newErrorResponse(e, log, http.StatusBadRequest, fmt.Sprintf("format must be %q when set", reportFormatCSV))
+		return nil
+	}
+
+	report, err := h.services.TimeTracking.GetTimeReport(userId, from, to)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusInternalServerError, err.Error())
+		return nil
+	}
+
+	log.Info("time report generated successfully", zap.Time("from", from), zap.Time("to", to), zap.Int64("total_seconds", report.TotalSeconds))
+	if format == reportFormatCSV {
+		w := e.Response()
+		w.Header().Set(echo.HeaderContentType, "text/csv; charset=utf-8")
+		w.Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", timeReportFilename))
+		w.WriteHeader(http.StatusOK)
+		return writeTimeReportCSV(w, report)
+	}
+	return e.JSON(http.StatusOK, report)
+}
+
+// parseReportRange reads the from and to dates of a report as UTC days and returns the range with an exclusive end.
+// Without dates the report covers the last 30 days up to today.
+func parseReportRange(e echo.Context, now time.Time) (time.Time, time.Time, error) {
+	today := now.UTC().Truncate(24 * time.Hour)
+	to := today
+	if value := e.QueryParam(reportToParam); value != isEmptyString {
+		date, err := time.Parse(reportDateLayout, value)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("to must be a date like %s", reportDateLayout)
+		}
+		to = date
+	}
+	from := to.AddDate(0, 0, -(defaultReportDays - 1))
+	if value := e.QueryParam(reportFromParam); value != isEmptyString {
+		date, err := time.Parse(reportDateLayout, value)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("from must be a date like %s", reportDateLayout)
+		}
+		from = date
+	}
+	return from, to.AddDate(0, 0, 1), nil
+}
+
+// writeTimeReportCSV writes one row per list, tag and day of the report, followed by the total.
+func writeTimeReportCSV(w io.Writer, report model.TimeReport) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"group", "key", "label", "seconds", "hours"})
+	groups := []struct {
+		name   string
+		totals []model.TimeTotal
+	}{
+		{"list", report.ByList},
+		{"tag", report.ByTag},
+		{"day", report.ByDay},
+		{"total", []model.TimeTotal{{Seconds: report.TotalSeconds}}},
+	}
+	for _, group := range groups {
+		for _, total := range group.totals {
+			cw.Write([]string{
+				group.name,
+				total.Key,
+				total.Label,
+				strconv.FormatInt(total.Seconds, 10),
+				strconv.FormatFloat(float64(total.Seconds)/3600, 'f', 2, 64),
+			})
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
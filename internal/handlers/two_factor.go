@@ -0,0 +1,143 @@
+package handlers
+
+import (
+	"TaskManager/internal/domain/model"
+	"TaskManager/internal/service"
+	"errors"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+	"net/http"
+)
+
+// enrollTwoFactor starts TOTP enrollment and returns the secret to add to an authenticator app.
+func (h *Handler) enrollTwoFactor(e echo.Context) error {
+	log := h.logger.With(
+		zap.String("handler", "enrollTwoFactor"),
+	)
+
+	userId, err := getUserId(e)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusUnauthorized, err.Error())
+		return nil
+	}
+
+	enrollment, err := h.services.TwoFactor.EnrollTwoFactor(userId)
+	if errors.Is(err, service.ErrTwoFactorEnabled) {
+		newErrorResponse(e, log, http.StatusBadRequest, err.Error())
+		return nil
+	}
+	if err != nil {
+		newErrorResponse(e, log, http.StatusInternalServerError, err.Error())
+		return nil
+	}
+
+	log.Info("two-factor enrollment started")
+	return e.JSON(http.StatusOK, enrollment)
+}
+
+// confirmTwoFactor enables two-factor authentication with a code from the authenticator app.
+// The response is the only place the recovery codes are returned.
+func (h *Handler) confirmTwoFactor(e echo.Context) error {
+	log := h.logger.With(
+		zap.String("handler", "confirmTwoFactor"),
+	)
+
+	userId, err := getUserId(e)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusUnauthorized, err.Error())
+		return nil
+	}
+
+	var input model.TwoFactorCodeInput
+	if err := e.Bind(&input); err != nil {
+		newErrorResponse(e, log, http.StatusBadRequest, err.Error())
+		return nil
+	}
+
+	codes, err := h.services.TwoFactor.ConfirmTwoFactor(userId, input.Code)
+	if errors.Is(err, service.ErrTwoFactorEnabled) || errors.Is(err, service.ErrTwoFactorNotEnabled) ||
+		errors.Is(err, service.ErrInvalidTwoFactorCode) {
+		newErrorResponse(e, log, http.StatusBadRequest, err.Error())
+		return nil
+	}
+	if err != nil {
+		newErrorResponse(e, log, http.StatusInternalServerError, err.Error())
+		return nil
+	}
+
+	log.Info("two-factor authentication enabled successfully")
+	return e.JSON(http.StatusOK, map[string]interface{}{
+		"recovery_codes": codes,
+	})
+}
+
+// disableTwoFactor turns off two-factor authentication once the user entered their password and a code again.
+func (h *Handler) disableTwoFactor(e echo.Context) error {
+	log := h.logger.With(
+		zap.String("handler", "disableTwoFactor"),
+	)
+
+	userId, err := getUserId(e)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusUnauthorized, err.Error())
+		return nil
+	}
+
+	var input model.DisableTwoFactorInput
+	if err := e.Bind(&input); err != nil {
+		newErrorResponse(e, log, http.StatusBadRequest, err.Error())
+		return nil
+	}
+
+	err = h.services.TwoFactor.DisableTwoFactor(userId, input)
+	if errors.Is(err, service.ErrInvalidPassword) || errors.Is(err, service.ErrInvalidTwoFactorCode) {
+		newErrorResponse(e, log, http.StatusForbidden, err.Error())
+		return nil
+	}
+	if errors.Is(err, service.ErrTwoFactorNotEnabled) {
+		newErrorResponse(e, log, http.StatusBadRequest, err.Error())
+		return nil
+	}
+	if err != nil {
+		newErrorResponse(e, log, http.StatusInternalServerError, err.Error())
+		return nil
+	}
+
+	log.Info("two-factor authentication disabled successfully")
+	return e.JSON(http.StatusOK, statusResponse{
+		Status: "two-factor authentication disabled successfully",
+	})
+}
+
+// verifyTwoFactor completes the login of a user with two-factor authentication.
+func (h *Handler) verifyTwoFactor(e echo.Context) error {
+	log := h.logger.With(
+		zap.String("handler", "verifyTwoFactor"),
+	)
+
+	var input model.TwoFactorLoginInput
+	if err := e.Bind(&input); err != nil {
+		newErrorResponse(e, log, http.StatusBadRequest, err.Error())
+		return nil
+	}
+
+	token, err := h.services.TwoFactor.VerifyTwoFactor(input.Challenge, input.Code)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusUnauthorized, err.Error())
+		return nil
+	}
+
+	log.Info("User logged in successfully")
+
+	return e.JSON(http.StatusOK, map[string]interface{}{
+		"token": token,
+	})
+}
+
+// twoFactorRequired answers a login whose password was accepted but that still needs a code.
+func twoFactorRequired(e echo.Context, challenge *service.TwoFactorChallenge) error {
+	return e.JSON(http.StatusOK, map[string]interface{}{
+		"two_factor_required": true,
+		"challenge":           challenge.Token,
+	})
+}
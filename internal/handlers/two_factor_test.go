@@ -0,0 +1,77 @@
+package handlers
+
+import (
+	"TaskManager/internal/domain/model"
+	"TaskManager/internal/service"
+	"go.uber.org/zap"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type fakeTwoFactorAuth struct {
+	fakeJWTs
+}
+
+func (fakeTwoFactorAuth) GenerateToken(username, password string) (string, error) {
+	return "", &service.TwoFactorChallenge{Token: "challenge-1"}
+}
+
+type fakeTwoFactor struct {
+	service.TwoFactor
+}
+
+func (fakeTwoFactor) VerifyTwoFactor(challenge, code string) (string, error) {
+	if challenge != "challenge-1" || code != "123456" {
+		return "", service.ErrInvalidTwoFactorCode
+	}
+	return "jwt", nil
+}
+
+func (fakeTwoFactor) DisableTwoFactor(userId string, input model.DisableTwoFactorInput) error {
+	if input.Password != "secret" {
+		return service.ErrInvalidPassword
+	}
+	return nil
+}
+
+func TestTwoFactorRoutes(t *testing.T) {
+	services := &service.Service{Authorization: fakeTwoFactorAuth{}, TwoFactor: fakeTwoFactor{}}
+	e := NewHandler(services, zap.NewNop(), Deprecation{}).InitRoutes(zap.NewNop())
+
+	testTable := []struct {
+		name       string
+		path       string
+		body       string
+		token      string
+		wantStatus int
+		wantBody   string
+	}{
+		{name: "Login Returns Challenge", path: "/api/v1/login", body: `{"username":"alice","password":"secret"}`, wantStatus: http.StatusOK, wantBody: `"challenge":"challenge-1"`},
+		{name: "Second Step", path: "/api/v1/login/2fa", body: `{"challenge":"challenge-1","code":"123456"}`, wantStatus: http.StatusOK, wantBody: `"token":"jwt"`},
+		{name: "Second Step With Wrong Code", path: "/api/v1/login/2fa", body: `{"challenge":"challenge-1","code":"000000"}`, wantStatus: http.StatusUnauthorized},
+		{name: "Disable", path: "/api/v1/me/2fa/disable", body: `{"password":"secret","code":"123456"}`, token: "jwt", wantStatus: http.StatusOK},
+		{name: "Disable With Wrong Password", path: "/api/v1/me/2fa/disable", body: `{"password":"guess","code":"123456"}`, token: "jwt", wantStatus: http.StatusForbidden},
+		{name: "Disable Without Token", path: "/api/v1/me/2fa/disable", body: `{"password":"secret","code":"123456"}`, wantStatus: http.StatusUnauthorized},
+	}
+
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, tt.path, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			if tt.token != "" {
+				req.Header.Set(authorizationHeader, "Bearer "+tt.token)
+			}
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d (body %s)", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+			if tt.wantBody != "" && !strings.Contains(rec.Body.String(), tt.wantBody) {
+				t.Errorf("body = %s, want %s", rec.Body.String(), tt.wantBody)
+			}
+		})
+	}
+}
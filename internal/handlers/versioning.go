@@ -0,0 +1,81 @@
+package handlers
+
+import (
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"net/http"
+	"time"
+)
+
+// apiV1Prefix is the path prefix of version 1 of the API.
+const apiV1Prefix = "/api/v1"
+
+const (
+	deprecationHeader = "Deprecation"
+	sunsetHeader      = "Sunset"
+	linkHeader        = "Link"
+)
+
+// Deprecation announces when the legacy routes were deprecated and when they stop being served.
+// Zero times are not announced.
+type Deprecation struct {
+	Date   time.Time
+	Sunset time.Time
+}
+
+// router is the part of *echo.Echo and *echo.Group that API versions register their routes with,
+// so the same routes can be mounted under several prefixes.
+type router interface {
+	Group(prefix string, m ...echo.MiddlewareFunc) *echo.Group
+	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+}
+
+// middlewareRouter adds middleware in front of every route and group registered through it.
+type middlewareRouter struct {
+	router
+	middleware []echo.MiddlewareFunc
+}
+
+// withMiddleware returns a router that runs the middleware before the middleware of each registered route.
+// Unlike a group without a prefix, it does not register catch-all routes at the root.
+func withMiddleware(r router, m ...echo.MiddlewareFunc) router {
+	return &middlewareRouter{router: r, middleware: m}
+}
+
+func (r *middlewareRouter) Group(prefix string, m ...echo.MiddlewareFunc) *echo.Group {
+	return r.router.Group(prefix, r.chain(m)...)
+}
+
+func (r *middlewareRouter) GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	return r.router.GET(path, h, r.chain(m)...)
+}
+
+func (r *middlewareRouter) POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	return r.router.POST(path, h, r.chain(m)...)
+}
+
+// chain returns the router's middleware followed by m.
+func (r *middlewareRouter) chain(m []echo.MiddlewareFunc) []echo.MiddlewareFunc {
+	return append(append([]echo.MiddlewareFunc{}, r.middleware...), m...)
+}
+
+// deprecatedMiddleware marks responses of legacy routes as deprecated and links to the same path under successorPrefix.
+// The Deprecation header follows RFC 9745 and the Sunset header RFC 8594.
+func (h *Handler) deprecatedMiddleware(successorPrefix string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			header := c.Response().Header()
+			if h.deprecation.Date.IsZero() {
+				header.Set(deprecationHeader, "true")
+			} else {
+				header.Set(deprecationHeader, fmt.Sprintf("@%d", h.deprecation.Date.Unix()))
+			}
+			if !h.deprecation.Sunset.IsZero() {
+				header.Set(sunsetHeader, h.deprecation.Sunset.UTC().Format(http.TimeFormat))
+			}
+			header.Add(linkHeader, fmt.Sprintf(`<%s%s>; rel="successor-version"`, successorPrefix, c.Request().URL.Path))
+			return next(c)
+		}
+	}
+}
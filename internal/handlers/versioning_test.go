@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"TaskManager/internal/service"
+	"go.uber.org/zap"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestLegacyRoutesAreDeprecated(t *testing.T) {
+	deprecation := Deprecation{
+		Date:   time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC),
+		Sunset: time.Date(2027, 4, 19, 0, 0, 0, 0, time.UTC),
+	}
+	e := NewHandler(&service.Service{}, zap.NewNop(), deprecation).InitRoutes(zap.NewNop())
+
+	testTable := []struct {
+		name            string
+		path            string
+		wantDeprecation string
+		wantSunset      string
+		wantLink        string
+	}{
+		{
+			name:            "Legacy",
+			path:            "/tasks/7",
+			wantDeprecation: "@1792368000",
+			wantSunset:      "Mon, 19 Apr 2027 00:00:00 GMT",
+			wantLink:        `</api/v1/tasks/7>; rel="successor-version"`,
+		},
+		{name: "Versioned", path: "/api/v1/tasks/7"},
+	}
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tt.path, nil))
+
+			// Without a token the request is rejected, but the deprecation is still announced.
+			if rec.Code != http.StatusUnauthorized {
+				t.Errorf("status = %d, want %d", rec.Code, http.StatusUnauthorized)
+			}
+			if got := rec.Header().Get(deprecationHeader); got != tt.wantDeprecation {
+				t.Errorf("Deprecation = %q, want %q", got, tt.wantDeprecation)
+			}
+			if got := rec.Header().Get(sunsetHeader); got != tt.wantSunset {
+				t.Errorf("Sunset = %q, want %q", got, tt.wantSunset)
+			}
+			if got := rec.Header().Get(linkHeader); got != tt.wantLink {
+				t.Errorf("Link = %q, want %q", got, tt.wantLink)
+			}
+		})
+	}
+}
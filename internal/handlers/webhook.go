@@ -0,0 +1,140 @@
+package handlers
+
+import (
+	"TaskManager/internal/domain/model"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+	"net/http"
+)
+
+// createWebhook subscribes the user to task list events. The response is the only place the secret is returned.
+func (h *Handler) createWebhook(e echo.Context) error {
+	log := h.logger.With(
+		zap.String("handler", "createWebhook"),
+	)
+
+	userId, err := getUserId(e)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusUnauthorized, err.Error())
+		return nil
+	}
+
+	var input model.Webhook
+	if err := e.Bind(&input); err != nil {
+		newErrorResponse(e, log, http.StatusBadRequest, err.Error())
+		return nil
+	}
+	log.Info("creating webhook", zap.String("url", input.URL), zap.Strings("events", input.Events))
+
+	hook, err := h.services.Webhook.Create(userId, input)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusBadRequest, err.Error())
+		return nil
+	}
+
+	log.Info("webhook created successfully", zap.String("webhook_id", hook.Id.Hex()))
+	return e.JSON(http.StatusOK, hook)
+}
+
+// getAllWebhooksResponse is the response structure for retrieving all webhooks.
+type getAllWebhooksResponse struct {
+	Data []model.Webhook `json:"data"`
+}
+
+// getWebhooks retrieves the user's webhook subscriptions.
+func (h *Handler) getWebhooks(e echo.Context) error {
+	log := h.logger.With(
+		zap.String("handler", "getWebhooks"),
+	)
+
+	userId, err := getUserId(e)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusUnauthorized, err.Error())
+		return nil
+	}
+
+	hooks, err := h.services.Webhook.GetAll(userId)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusInternalServerError, err.Error())
+		return nil
+	}
+
+	log.Info("webhooks retrieved successfully", zap.Int("webhook_count", len(hooks)))
+	return e.JSON(http.StatusOK, getAllWebhooksResponse{
+		Data: hooks,
+	})
+}
+
+// getWebhookByID retrieves a specific webhook subscription.
+func (h *Handler) getWebhookByID(e echo.Context) error {
+	log := h.logger.With(
+		zap.String("handler", "getWebhookByID"),
+	)
+
+	userId, err := getUserId(e)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusUnauthorized, err.Error())
+		return nil
+	}
+
+	hook, err := h.services.Webhook.GetById(userId, e.Param("id"))
+	if err != nil {
+		newErrorResponse(e, log, http.StatusNotFound, err.Error())
+		return nil
+	}
+
+	return e.JSON(http.StatusOK, hook)
+}
+
+// deleteWebhook removes a webhook subscription.
+func (h *Handler) deleteWebhook(e echo.Context) error {
+	log := h.logger.With(
+		zap.String("handler", "deleteWebhook"),
+	)
+
+	userId, err := getUserId(e)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusUnauthorized, err.Error())
+		return nil
+	}
+
+	webhookId := e.Param("id")
+	if err := h.services.Webhook.Delete(userId, webhookId); err != nil {
+		newErrorResponse(e, log, http.StatusNotFound, err.Error())
+		return nil
+	}
+
+	log.Info("webhook deleted successfully", zap.String("webhook_id", webhookId))
+	return e.JSON(http.StatusOK, statusResponse{
+		Status: "Webhook deleted successfully",
+	})
+}
+
+// getWebhookDeliveriesResponse is the response structure for retrieving a webhook's delivery log.
+type getWebhookDeliveriesResponse struct {
+	Data []model.WebhookDelivery `json:"data"`
+}
+
+// getWebhookDeliveries retrieves the delivery log of a webhook subscription.
+func (h *Handler) getWebhookDeliveries(e echo.Context) error {
+	log := h.logger.With(
+		zap.String("handler", "getWebhookDeliveries"),
+	)
+
+	userId, err := getUserId(e)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusUnauthorized, err.Error())
+		return nil
+	}
+
+	deliveries, err := h.services.Webhook.GetDeliveries(userId, e.Param("id"))
+	if err != nil {
+		newErrorResponse(e, log, http.StatusNotFound, err.Error())
+		return nil
+	}
+
+	log.Info("webhook deliveries retrieved successfully", zap.Int("delivery_count", len(deliveries)))
+	return e.JSON(http.StatusOK, getWebhookDeliveriesResponse{
+		Data: deliveries,
+	})
+}
@@ -0,0 +1,226 @@
+package handlers
+
+import (
+	"TaskManager/internal/domain/model"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+	"net/http"
+)
+
+// createWorkspace creates a new workspace owned by the user.
+func (h *Handler) createWorkspace(e echo.Context) error {
+	log := h.logger.With(
+		zap.String("handler", "createWorkspace"),
+	)
+
+	userId, err := getUserId(e)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusUnauthorized, err.Error())
+		return nil
+	}
+
+	var input model.WorkspaceInput
+	if err := e.Bind(&input); err != nil {
+		newErrorResponse(e, log, http.StatusBadRequest, err.Error())
+		return nil
+	}
+
+	id, err := h.services.Workspace.Create(userId, input)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusInternalServerError, err.Error())
+		return nil
+	}
+
+	log.Info("workspace created successfully", zap.String("workspace_id", id))
+	return e.JSON(http.StatusOK, map[string]interface{}{
+		"id": id,
+	})
+}
+
+// getAllWorkspacesResponse is the response structure for retrieving all workspaces.
+type getAllWorkspacesResponse struct {
+	Data []model.Workspace `json:"data"`
+}
+
+// getWorkspaces retrieves the workspaces the user is a member of.
+func (h *Handler) getWorkspaces(e echo.Context) error {
+	log := h.logger.With(
+		zap.String("handler", "getWorkspaces"),
+	)
+
+	userId, err := getUserId(e)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusUnauthorized, err.Error())
+		return nil
+	}
+
+	workspaces, err := h.services.Workspace.GetAll(userId)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusInternalServerError, err.Error())
+		return nil
+	}
+
+	log.Info("workspaces retrieved successfully", zap.Int("workspace_count", len(workspaces)))
+	return e.JSON(http.StatusOK, getAllWorkspacesResponse{
+		Data: workspaces,
+	})
+}
+
+// getWorkspaceByID retrieves a specific workspace.
+func (h *Handler) getWorkspaceByID(e echo.Context) error {
+	log := h.logger.With(
+		zap.String("handler", "getWorkspaceByID"),
+	)
+
+	userId, err := getUserId(e)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusUnauthorized, err.Error())
+		return nil
+	}
+
+	workspace, err := h.services.Workspace.GetById(userId, e.Param("id"))
+	if err != nil {
+		newErrorResponse(e, log, http.StatusNotFound, err.Error())
+		return nil
+	}
+
+	return e.JSON(http.StatusOK, workspace)
+}
+
+// updateWorkspace renames a workspace.
+func (h *Handler) updateWorkspace(e echo.Context) error {
+	log := h.logger.With(
+		zap.String("handler", "updateWorkspace"),
+	)
+
+	userId, err := getUserId(e)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusUnauthorized, err.Error())
+		return nil
+	}
+
+	var input model.WorkspaceInput
+	if err := e.Bind(&input); err != nil {
+		newErrorResponse(e, log, http.StatusBadRequest, err.Error())
+		return nil
+	}
+
+	workspaceId := e.Param("id")
+	if err := h.services.Workspace.Update(userId, workspaceId, input); err != nil {
+		newErrorResponse(e, log, http.StatusInternalServerError, err.Error())
+		return nil
+	}
+
+	log.Info("workspace updated successfully", zap.String("workspace_id", workspaceId))
+	return e.JSON(http.StatusOK, statusResponse{
+		Status: "Workspace updated successfully",
+	})
+}
+
+// deleteWorkspace deletes a workspace.
+func (h *Handler) deleteWorkspace(e echo.Context) error {
+	log := h.logger.With(
+		zap.String("handler", "deleteWorkspace"),
+	)
+
+	userId, err := getUserId(e)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusUnauthorized, err.Error())
+		return nil
+	}
+
+	workspaceId := e.Param("id")
+	if err := h.services.Workspace.Delete(userId, workspaceId); err != nil {
+		newErrorResponse(e, log, http.StatusInternalServerError, err.Error())
+		return nil
+	}
+
+	log.Info("workspace deleted successfully", zap.String("workspace_id", workspaceId))
+	return e.JSON(http.StatusOK, statusResponse{
+		Status: "Workspace deleted successfully",
+	})
+}
+
+// addWorkspaceMember adds a user to a workspace by username.
+func (h *Handler) addWorkspaceMember(e echo.Context) error {
+	log := h.logger.With(
+		zap.String("handler", "addWorkspaceMember"),
+	)
+
+	userId, err := getUserId(e)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusUnauthorized, err.Error())
+		return nil
+	}
+
+	var input model.WorkspaceMemberInput
+	if err := e.Bind(&input); err != nil {
+		newErrorResponse(e, log, http.StatusBadRequest, err.Error())
+		return nil
+	}
+
+	workspaceId := e.Param("id")
+	if err := h.services.Workspace.AddMember(userId, workspaceId, input); err != nil {
+		newErrorResponse(e, log, http.StatusInternalServerError, err.Error())
+		return nil
+	}
+
+	log.Info("workspace member added successfully", zap.String("workspace_id", workspaceId), zap.String("username", input.Username))
+	return e.JSON(http.StatusOK, statusResponse{
+		Status: "Member added successfully",
+	})
+}
+
+// updateWorkspaceMember changes the role of a workspace member.
+func (h *Handler) updateWorkspaceMember(e echo.Context) error {
+	log := h.logger.With(
+		zap.String("handler", "updateWorkspaceMember"),
+	)
+
+	userId, err := getUserId(e)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusUnauthorized, err.Error())
+		return nil
+	}
+
+	var input model.WorkspaceMemberInput
+	if err := e.Bind(&input); err != nil {
+		newErrorResponse(e, log, http.StatusBadRequest, err.Error())
+		return nil
+	}
+
+	workspaceId, memberId := e.Param("id"), e.Param("userId")
+	if err := h.services.Workspace.UpdateMember(userId, workspaceId, memberId, input.Role); err != nil {
+		newErrorResponse(e, log, http.StatusInternalServerError, err.Error())
+		return nil
+	}
+
+	log.Info("workspace member updated successfully", zap.String("workspace_id", workspaceId), zap.String("member_id", memberId))
+	return e.JSON(http.StatusOK, statusResponse{
+		Status: "Member updated successfully",
+	})
+}
+
+// removeWorkspaceMember removes a member from a workspace.
+func (h *Handler) removeWorkspaceMember(e echo.Context) error {
+	log := h.logger.With(
+		zap.String("handler", "removeWorkspaceMember"),
+	)
+
+	userId, err := getUserId(e)
+	if err != nil {
+		newErrorResponse(e, log, http.StatusUnauthorized, err.Error())
+		return nil
+	}
+
+	workspaceId, memberId := e.Param("id"), e.Param("userId")
+	if err := h.services.Workspace.RemoveMember(userId, workspaceId, memberId); err != nil {
+		newErrorResponse(e, log, http.StatusInternalServerError, err.Error())
+		return nil
+	}
+
+	log.Info("workspace member removed successfully", zap.String("workspace_id", workspaceId), zap.String("member_id", memberId))
+	return e.JSON(http.StatusOK, statusResponse{
+		Status: "Member removed successfully",
+	})
+}
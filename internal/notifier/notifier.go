@@ -0,0 +1,20 @@
+package notifier
+
+import (
+	"TaskManager/internal/domain/model"
+	"context"
+)
+
+// Message is a notification about a task list addressed to its owner.
+type Message struct {
+	Recipient model.User
+	List      model.TaskList
+	Subject   string
+	Body      string
+}
+
+// Notifier delivers messages through a single channel such as a webhook or email.
+type Notifier interface {
+	Name() string
+	Notify(ctx context.Context, msg Message) error
+}
@@ -0,0 +1,62 @@
+package notifier
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strings"
+)
+
+// headerReplacer strips line breaks so user-provided text cannot inject mail headers.
+var headerReplacer = strings.NewReplacer("\r", " ", "\n", " ")
+
+// SMTPNotifier sends messages by email through an SMTP server.
+type SMTPNotifier struct {
+	addr string
+	from string
+	auth smtp.Auth
+}
+
+// NewSMTPNotifier creates a new SMTPNotifier. Authentication is skipped when username is empty.
+func NewSMTPNotifier(host string, port int, username, password, from string) *SMTPNotifier {
+	var auth smtp.Auth
+	if username != "" {
+		auth = smtp.PlainAuth("", username, password, host)
+	}
+	return &SMTPNotifier{
+		addr: net.JoinHostPort(host, fmt.Sprint(port)),
+		from: from,
+		auth: auth,
+	}
+}
+
+// Name returns the channel name used when recording deliveries.
+func (s *SMTPNotifier) Name() string {
+	return "smtp"
+}
+
+// Notify emails the message to the recipient's address.
+func (s *SMTPNotifier) Notify(ctx context.Context, msg Message) error {
+	if msg.Recipient.Email == "" {
+		return errors.New("recipient has no email address")
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "From: %s\r\n", s.from)
+	fmt.Fprintf(&b, "To: %s\r\n", msg.Recipient.Email)
+	fmt.Fprintf(&b, "Subject: %s\r\n", headerReplacer.Replace(msg.Subject))
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(msg.Body)
+	b.WriteString("\r\n")
+
+	if err := smtp.SendMail(s.addr, s.auth, s.from, []string{msg.Recipient.Email}, []byte(b.String())); err != nil {
+		return fmt.Errorf("error sending email: %w", err)
+	}
+	return nil
+}
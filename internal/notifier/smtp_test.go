@@ -0,0 +1,120 @@
+package notifier
+
+import (
+	"TaskManager/internal/domain/model"
+	"bufio"
+	"context"
+	"net"
+	"strings"
+	"testing"
+)
+
+// fakeSMTPServer accepts a single SMTP session and records the received message.
+type fakeSMTPServer struct {
+	listener net.Listener
+	from     string
+	rcpt     []string
+	data     string
+	done     chan struct{}
+}
+
+func newFakeSMTPServer(t *testing.T) *fakeSMTPServer {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	s := &fakeSMTPServer{listener: l, done: make(chan struct{})}
+	go s.serve()
+	t.Cleanup(func() { l.Close() })
+	return s
+}
+
+func (s *fakeSMTPServer) serve() {
+	defer close(s.done)
+
+	conn, err := s.listener.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	r := bufio.NewReader(conn)
+	reply := func(line string) { conn.Write([]byte(line + "\r\n")) }
+
+	reply("220 localhost fake SMTP")
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		cmd := strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(cmd, "EHLO"), strings.HasPrefix(cmd, "HELO"):
+			reply("250 localhost")
+		case strings.HasPrefix(cmd, "MAIL FROM:"):
+			s.from = strings.Trim(strings.TrimPrefix(cmd, "MAIL FROM:"), "<>")
+			reply("250 OK")
+		case strings.HasPrefix(cmd, "RCPT TO:"):
+			s.rcpt = append(s.rcpt, strings.Trim(strings.TrimPrefix(cmd, "RCPT TO:"), "<>"))
+			reply("250 OK")
+		case cmd == "DATA":
+			reply("354 End data with <CR><LF>.<CR><LF>")
+			var b strings.Builder
+			for {
+				l, err := r.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if l == ".\r\n" {
+					break
+				}
+				b.WriteString(l)
+			}
+			s.data = b.String()
+			reply("250 OK")
+		case cmd == "QUIT":
+			reply("221 Bye")
+			return
+		default:
+			reply("250 OK")
+		}
+	}
+}
+
+func TestSMTPNotifier_Notify(t *testing.T) {
+	server := newFakeSMTPServer(t)
+	addr := server.listener.Addr().(*net.TCPAddr)
+
+	n := NewSMTPNotifier("127.0.0.1", addr.Port, "", "", "tasks@example.com")
+	err := n.Notify(context.Background(), Message{
+		Recipient: model.User{Username: "alice", Email: "alice@example.com"},
+		List:      model.TaskList{Id: 1, Title: "Weekly review"},
+		Subject:   "Reminder: Weekly review\r\nBcc: evil@example.com",
+		Body:      "Weekly review is due soon",
+	})
+	if err != nil {
+		t.Fatalf("Notify() unexpected error: %v", err)
+	}
+	<-server.done
+
+	if server.from != "tasks@example.com" {
+		t.Errorf("MAIL FROM = %s, want tasks@example.com", server.from)
+	}
+	if len(server.rcpt) != 1 || server.rcpt[0] != "alice@example.com" {
+		t.Errorf("RCPT TO = %v, want [alice@example.com]", server.rcpt)
+	}
+	if !strings.Contains(server.data, "Subject: Reminder: Weekly review  Bcc: evil@example.com\r\n") {
+		t.Errorf("message subject was not sanitized:\n%s", server.data)
+	}
+	if !strings.Contains(server.data, "Weekly review is due soon") {
+		t.Errorf("message body missing:\n%s", server.data)
+	}
+}
+
+func TestSMTPNotifier_NotifyWithoutEmail(t *testing.T) {
+	n := NewSMTPNotifier("127.0.0.1", 25, "", "", "tasks@example.com")
+	err := n.Notify(context.Background(), Message{Recipient: model.User{Username: "bob"}})
+	if err == nil {
+		t.Error("Notify() expected error for recipient without email")
+	}
+}
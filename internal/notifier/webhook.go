@@ -0,0 +1,70 @@
+package notifier
+
+import (
+	"TaskManager/internal/domain/model"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// WebhookNotifier posts messages as JSON to a fixed URL.
+type WebhookNotifier struct {
+	url    string
+	client *http.Client
+}
+
+// webhookPayload is the JSON body sent by WebhookNotifier.
+type webhookPayload struct {
+	UserId   string         `json:"user_id"`
+	Username string         `json:"username"`
+	Subject  string         `json:"subject"`
+	Body     string         `json:"body"`
+	TaskList model.TaskList `json:"task_list"`
+}
+
+// NewWebhookNotifier creates a new WebhookNotifier posting to the provided URL.
+func NewWebhookNotifier(url string) *WebhookNotifier {
+	return &WebhookNotifier{
+		url:    url,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Name returns the channel name used when recording deliveries.
+func (w *WebhookNotifier) Name() string {
+	return "webhook"
+}
+
+// Notify posts the message to the webhook URL and fails on any non-2xx response.
+func (w *WebhookNotifier) Notify(ctx context.Context, msg Message) error {
+	body, err := json.Marshal(webhookPayload{
+		UserId:   msg.Recipient.Id.Hex(),
+		Username: msg.Recipient.Username,
+		Subject:  msg.Subject,
+		Body:     msg.Body,
+		TaskList: msg.List,
+	})
+	if err != nil {
+		return fmt.Errorf("error encoding webhook payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("error creating webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("error sending webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
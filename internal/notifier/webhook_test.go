@@ -0,0 +1,54 @@
+package notifier
+
+import (
+	"TaskManager/internal/domain/model"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestWebhookNotifier_Notify(t *testing.T) {
+	testTable := []struct {
+		name    string
+		status  int
+		wantErr bool
+	}{
+		{
+			name:    "Accepted",
+			status:  http.StatusNoContent,
+			wantErr: false,
+		},
+		{
+			name:    "Server Error",
+			status:  http.StatusInternalServerError,
+			wantErr: true,
+		},
+	}
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			var received webhookPayload
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+					t.Errorf("failed to decode payload: %v", err)
+				}
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			n := NewWebhookNotifier(server.URL)
+			err := n.Notify(context.Background(), Message{
+				Recipient: model.User{Username: "alice"},
+				List:      model.TaskList{Id: 7, Title: "Release checklist"},
+				Subject:   "Reminder",
+			})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Notify() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if received.Username != "alice" || received.TaskList.Id != 7 {
+				t.Errorf("unexpected payload: %+v", received)
+			}
+		})
+	}
+}
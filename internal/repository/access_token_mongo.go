@@ -0,0 +1,120 @@
+package repository
+
+import (
+	"TaskManager/internal/domain/model"
+	"context"
+	"errors"
+	"fmt"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"time"
+)
+
+// AccessTokenMongo stores personal access tokens in MongoDB.
+type AccessTokenMongo struct {
+	collection *mongo.Collection
+}
+
+// NewAccessTokenMongo initializes a new AccessTokenMongo instance with the provided MongoDB client and database name.
+func NewAccessTokenMongo(client *mongo.Client, dbName string) *AccessTokenMongo {
+	return &AccessTokenMongo{
+		collection: client.Database(dbName).Collection("access_tokens"),
+	}
+}
+
+// Create inserts a new personal access token and returns its ID.
+func (a *AccessTokenMongo) Create(token model.AccessToken) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	token.Id = bson.NewObjectID()
+	if _, err := a.collection.InsertOne(ctx, token); err != nil {
+		return "", fmt.Errorf("error inserting access token: %w", err)
+	}
+	return token.Id.Hex(), nil
+}
+
+// GetAll retrieves the personal access tokens of the user, newest first.
+func (a *AccessTokenMongo) GetAll(userId string) ([]model.AccessToken, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	cursor, err := a.collection.Find(ctx, bson.M{"user_id": userId}, options.Find().SetSort(bson.M{"created_at": -1}))
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving access tokens: %w", err)
+	}
+	tokens := []model.AccessToken{}
+	if err := cursor.All(ctx, &tokens); err != nil {
+		return nil, fmt.Errorf("error decoding access tokens: %w", err)
+	}
+	return tokens, nil
+}
+
+// GetByHash retrieves the personal access token with the given hash.
+func (a *AccessTokenMongo) GetByHash(hash string) (model.AccessToken, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var token model.AccessToken
+	err := a.collection.FindOne(ctx, bson.M{"hash": hash}).Decode(&token)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return model.AccessToken{}, fmt.Errorf("access token not found")
+		}
+		return model.AccessToken{}, fmt.Errorf("error retrieving access token: %w", err)
+	}
+	return token, nil
+}
+
+// Revoke marks a personal access token of the user as revoked. A revoked token stays listed.
+func (a *AccessTokenMongo) Revoke(userId string, id string, at time.Time) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	objectId, err := bson.ObjectIDFromHex(id)
+	if err != nil {
+		return fmt.Errorf("invalid access token id: %w", err)
+	}
+
+	filter := bson.M{"_id": objectId, "user_id": userId, "revoked_at": bson.M{"$exists": false}}
+	result, err := a.collection.UpdateOne(ctx, filter, bson.M{"$set": bson.M{"revoked_at": at}})
+	if err != nil {
+		return fmt.Errorf("error revoking access token: %w", err)
+	}
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("active access token %s not found for user %s", id, userId)
+	}
+	return nil
+}
+
+// SetLastUsed records when a personal access token was last used.
+func (a *AccessTokenMongo) SetLastUsed(id string, at time.Time) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	objectId, err := bson.ObjectIDFromHex(id)
+	if err != nil {
+		return fmt.Errorf("invalid access token id: %w", err)
+	}
+
+	if _, err := a.collection.UpdateOne(ctx, bson.M{"_id": objectId}, bson.M{"$set": bson.M{"last_used_at": at}}); err != nil {
+		return fmt.Errorf("error updating access token: %w", err)
+	}
+	return nil
+}
+
+// EnsureIndexes creates the indexes used to look tokens up by hash and to list a user's tokens.
+func (a *AccessTokenMongo) EnsureIndexes() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	_, err := a.collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "hash", Value: 1}}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "created_at", Value: -1}}},
+	})
+	if err != nil {
+		return fmt.Errorf("error creating access token indexes: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"TaskManager/internal/domain/model"
+	"context"
+	"errors"
+	"fmt"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"time"
+)
+
+// AttachmentMongo stores the metadata of task list attachments in MongoDB.
+type AttachmentMongo struct {
+	collection *mongo.Collection
+}
+
+// NewAttachmentMongo initializes a new AttachmentMongo instance with the provided MongoDB client and database name.
+func NewAttachmentMongo(client *mongo.Client, dbName string) *AttachmentMongo {
+	return &AttachmentMongo{
+		collection: client.Database(dbName).Collection("attachments"),
+	}
+}
+
+// Create inserts the metadata of an attachment and returns its ID. An ID set by the caller is kept.
+func (a *AttachmentMongo) Create(attachment model.Attachment) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if attachment.Id.IsZero() {
+		attachment.Id = bson.NewObjectID()
+	}
+	if _, err := a.collection.InsertOne(ctx, attachment); err != nil {
+		return "", fmt.Errorf("error inserting attachment: %w", err)
+	}
+	return attachment.Id.Hex(), nil
+}
+
+// GetAll retrieves the attachments of a task list, oldest first.
+func (a *AttachmentMongo) GetAll(listId int) ([]model.Attachment, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}, {Key: "_id", Value: 1}})
+	cursor, err := a.collection.Find(ctx, bson.M{"list_id": listId}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving attachments: %w", err)
+	}
+
+	attachments := []model.Attachment{}
+	if err := cursor.All(ctx, &attachments); err != nil {
+		return nil, fmt.Errorf("error decoding attachments: %w", err)
+	}
+	return attachments, nil
+}
+
+// GetById retrieves an attachment of a task list.
+func (a *AttachmentMongo) GetById(listId int, id string) (model.Attachment, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	objectId, err := bson.ObjectIDFromHex(id)
+	if err != nil {
+		return model.Attachment{}, fmt.Errorf("invalid attachment id: %w", err)
+	}
+
+	var attachment model.Attachment
+	err = a.collection.FindOne(ctx, bson.M{"_id": objectId, "list_id": listId}).Decode(&attachment)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return model.Attachment{}, fmt.Errorf("attachment %s not found on task list %d", id, listId)
+		}
+		return model.Attachment{}, fmt.Errorf("error retrieving attachment: %w", err)
+	}
+	return attachment, nil
+}
+
+// Delete removes the metadata of an attachment.
+func (a *AttachmentMongo) Delete(id string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	objectId, err := bson.ObjectIDFromHex(id)
+	if err != nil {
+		return fmt.Errorf("invalid attachment id: %w", err)
+	}
+
+	res, err := a.collection.DeleteOne(ctx, bson.M{"_id": objectId})
+	if err != nil {
+		return fmt.Errorf("error deleting attachment: %w", err)
+	}
+	if res.DeletedCount == 0 {
+		return fmt.Errorf("attachment %s not found", id)
+	}
+	return nil
+}
+
+// EnsureIndexes creates the index used to list the attachments of a task list.
+func (a *AttachmentMongo) EnsureIndexes() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	_, err := a.collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "list_id", Value: 1}, {Key: "created_at", Value: 1}},
+	})
+	if err != nil {
+		return fmt.Errorf("error creating attachment indexes: %w", err)
+	}
+	return nil
+}
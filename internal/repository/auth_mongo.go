@@ -7,11 +7,15 @@ import (
 	"fmt"
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
 	"golang.org/x/crypto/bcrypt"
 	"hash/fnv"
 	"time"
 )
 
+// ErrUserNotFound is returned by the user lookups that find no user.
+var ErrUserNotFound = errors.New("user not found")
+
 // Auth defines the interface for authentication-related operations.
 type AuthMongo struct {
 	collection *mongo.Collection
@@ -35,6 +39,9 @@ func (a *AuthMongo) CreateUser(user model.User) (int, error) {
 
 	_, err := a.collection.InsertOne(ctx, user)
 	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return 0, errors.New("a user with this email address or identity already exists")
+		}
 		return 0, fmt.Errorf("error inserting user: %w", err)
 	}
 
@@ -56,7 +63,7 @@ func (a *AuthMongo) GetUser(username string, password string) (model.User, error
 	err := a.collection.FindOne(ctx, filter).Decode(&user)
 	if err != nil {
 		if errors.Is(err, mongo.ErrNoDocuments) {
-			return model.User{}, fmt.Errorf("user not found")
+			return model.User{}, ErrUserNotFound
 		}
 		return model.User{}, err
 	}
@@ -69,3 +76,288 @@ func (a *AuthMongo) GetUser(username string, password string) (model.User, error
 
 	return user, nil
 }
+
+// GetUserById is a repository method for finding a user by their hex ID.
+func (a *AuthMongo) GetUserById(id string) (model.User, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	objectId, err := bson.ObjectIDFromHex(id)
+	if err != nil {
+		return model.User{}, fmt.Errorf("invalid user id: %w", err)
+	}
+
+	var user model.User
+	err = a.collection.FindOne(ctx, bson.M{"_id": objectId}).Decode(&user)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return model.User{}, ErrUserNotFound
+		}
+		return model.User{}, err
+	}
+
+	return user, nil
+}
+
+// GetUsersByIds is a repository method for finding the users with the given hex IDs in a single query.
+// IDs of users that do not exist are left out.
+func (a *AuthMongo) GetUsersByIds(ids []string) ([]model.User, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	users := []model.User{}
+	if len(ids) == 0 {
+		return users, nil
+	}
+	objectIds := make([]bson.ObjectID, 0, len(ids))
+	for _, id := range ids {
+		objectId, err := bson.ObjectIDFromHex(id)
+		if err != nil {
+			return nil, fmt.Errorf("invalid user id: %w", err)
+		}
+		objectIds = append(objectIds, objectId)
+	}
+
+	cursor, err := a.collection.Find(ctx, bson.M{"_id": bson.M{"$in": objectIds}})
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving users: %w", err)
+	}
+	if err := cursor.All(ctx, &users); err != nil {
+		return nil, fmt.Errorf("error decoding users: %w", err)
+	}
+	return users, nil
+}
+
+// GetUserByUsername is a repository method for finding a user by their username without checking the password.
+func (a *AuthMongo) GetUserByUsername(username string) (model.User, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var user model.User
+	err := a.collection.FindOne(ctx, bson.M{"username": username}).Decode(&user)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return model.User{}, ErrUserNotFound
+		}
+		return model.User{}, err
+	}
+
+	return user, nil
+}
+
+// GetUserByIdentity is a repository method for finding the user linked to an external identity.
+func (a *AuthMongo) GetUserByIdentity(identity model.ExternalIdentity) (model.User, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	filter := bson.M{"identities": bson.M{"$elemMatch": bson.M{"issuer": identity.Issuer, "subject": identity.Subject}}}
+
+	var user model.User
+	err := a.collection.FindOne(ctx, filter).Decode(&user)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return model.User{}, ErrUserNotFound
+		}
+		return model.User{}, err
+	}
+
+	return user, nil
+}
+
+// GetUserByEmail is a repository method for finding a user by their email address.
+func (a *AuthMongo) GetUserByEmail(email string) (model.User, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var user model.User
+	err := a.collection.FindOne(ctx, bson.M{"email": email}).Decode(&user)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return model.User{}, ErrUserNotFound
+		}
+		return model.User{}, err
+	}
+
+	return user, nil
+}
+
+// AddIdentity links an external identity to a user.
+func (a *AuthMongo) AddIdentity(id string, identity model.ExternalIdentity) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	objectId, err := bson.ObjectIDFromHex(id)
+	if err != nil {
+		return fmt.Errorf("invalid user id: %w", err)
+	}
+
+	result, err := a.collection.UpdateOne(ctx, bson.M{"_id": objectId}, bson.M{"$addToSet": bson.M{"identities": identity}})
+	if err != nil {
+		return fmt.Errorf("error linking identity: %w", err)
+	}
+	if result.MatchedCount == 0 {
+		return ErrUserNotFound
+	}
+	return nil
+}
+
+// EnsureIndexes creates the indexes used by user lookups. An email address and an external identity
+// can each belong to one user only.
+func (a *AuthMongo) EnsureIndexes() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// The email index used to allow duplicates, and an index cannot be made unique in place.
+	if err := a.collection.Indexes().DropOne(ctx, "email_1"); err != nil && !isMissingIndex(err) {
+		return fmt.Errorf("error dropping the old email index: %w", err)
+	}
+
+	_, err := a.collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "username", Value: 1}}},
+		{
+			Keys: bson.D{{Key: "email", Value: 1}},
+			Options: options.Index().SetName("email_unique").SetUnique(true).
+				SetPartialFilterExpression(bson.M{"email": bson.M{"$type": "string"}}),
+		},
+		{
+			Keys:    bson.D{{Key: "identities.issuer", Value: 1}, {Key: "identities.subject", Value: 1}},
+			Options: options.Index().SetUnique(true).SetSparse(true),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error creating user indexes: %w", err)
+	}
+	return nil
+}
+
+// isMissingIndex reports whether an index could not be dropped because it, or its collection, does not exist.
+func isMissingIndex(err error) bool {
+	var cmdErr mongo.CommandError
+	return errors.As(err, &cmdErr) && (cmdErr.Code == 26 || cmdErr.Code == 27)
+}
+
+// SetPassword replaces the password hash of a user.
+func (a *AuthMongo) SetPassword(id string, passwordHash string) error {
+	return a.updateUser(id, bson.M{"password": passwordHash})
+}
+
+// SetDisabled disables or re-enables the account of a user.
+func (a *AuthMongo) SetDisabled(id string, disabled bool) error {
+	return a.updateUser(id, bson.M{"disabled": disabled})
+}
+
+// SetTwoFactor stores the second factor of a user.
+func (a *AuthMongo) SetTwoFactor(id string, twoFactor model.TwoFactor) error {
+	return a.updateUser(id, bson.M{"two_factor": twoFactor})
+}
+
+// RemoveTwoFactor turns off two-factor authentication for a user and forgets the secret and recovery codes.
+func (a *AuthMongo) RemoveTwoFactor(id string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	objectId, err := bson.ObjectIDFromHex(id)
+	if err != nil {
+		return fmt.Errorf("invalid user id: %w", err)
+	}
+
+	result, err := a.collection.UpdateOne(ctx, bson.M{"_id": objectId}, bson.M{"$unset": bson.M{"two_factor": ""}})
+	if err != nil {
+		return fmt.Errorf("error removing two-factor authentication: %w", err)
+	}
+	if result.MatchedCount == 0 {
+		return ErrUserNotFound
+	}
+	return nil
+}
+
+// AcceptTwoFactorStep records the time step of an accepted code and clears the failures. It reports false when
+// a code of the same or a later step was accepted already, so concurrent logins cannot both use one code,
+// or when maxFailures invalid codes were entered, even by a login running at the same time.
+func (a *AuthMongo) AcceptTwoFactorStep(id string, step int64, maxFailures int) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	objectId, err := bson.ObjectIDFromHex(id)
+	if err != nil {
+		return false, fmt.Errorf("invalid user id: %w", err)
+	}
+
+	filter := bson.M{
+		"_id":                 objectId,
+		"two_factor":          bson.M{"$exists": true},
+		"two_factor.failures": bson.M{"$not": bson.M{"$gte": maxFailures}},
+		"$or": bson.A{
+			bson.M{"two_factor.last_step": bson.M{"$exists": false}},
+			bson.M{"two_factor.last_step": bson.M{"$lt": step}},
+		},
+	}
+	result, err := a.collection.UpdateOne(ctx, filter, bson.M{"$set": bson.M{"two_factor.last_step": step, "two_factor.failures": 0}})
+	if err != nil {
+		return false, fmt.Errorf("error recording two-factor code: %w", err)
+	}
+	return result.MatchedCount == 1, nil
+}
+
+// UseRecoveryCode removes the recovery code with the given hash from a user and clears the failures. It reports
+// false when the user has no such code, which makes every code usable once even under concurrent logins.
+func (a *AuthMongo) UseRecoveryCode(id string, hash string) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	objectId, err := bson.ObjectIDFromHex(id)
+	if err != nil {
+		return false, fmt.Errorf("invalid user id: %w", err)
+	}
+
+	filter := bson.M{"_id": objectId, "two_factor.recovery_codes": hash}
+	result, err := a.collection.UpdateOne(ctx, filter, bson.M{
+		"$pull": bson.M{"two_factor.recovery_codes": hash},
+		"$set":  bson.M{"two_factor.failures": 0},
+	})
+	if err != nil {
+		return false, fmt.Errorf("error using recovery code: %w", err)
+	}
+	return result.MatchedCount == 1, nil
+}
+
+// RecordTwoFactorFailure counts an invalid code entered by a user, up to maxFailures.
+func (a *AuthMongo) RecordTwoFactorFailure(id string, maxFailures int) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	objectId, err := bson.ObjectIDFromHex(id)
+	if err != nil {
+		return fmt.Errorf("invalid user id: %w", err)
+	}
+
+	filter := bson.M{
+		"_id":                 objectId,
+		"two_factor":          bson.M{"$exists": true},
+		"two_factor.failures": bson.M{"$not": bson.M{"$gte": maxFailures}},
+	}
+	if _, err := a.collection.UpdateOne(ctx, filter, bson.M{"$inc": bson.M{"two_factor.failures": 1}}); err != nil {
+		return fmt.Errorf("error recording two-factor failure: %w", err)
+	}
+	return nil
+}
+
+// updateUser sets the given fields of a user.
+func (a *AuthMongo) updateUser(id string, fields bson.M) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	objectId, err := bson.ObjectIDFromHex(id)
+	if err != nil {
+		return fmt.Errorf("invalid user id: %w", err)
+	}
+
+	result, err := a.collection.UpdateOne(ctx, bson.M{"_id": objectId}, bson.M{"$set": fields})
+	if err != nil {
+		return fmt.Errorf("error updating user: %w", err)
+	}
+	if result.MatchedCount == 0 {
+		return ErrUserNotFound
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+package repository
+
+import (
+	"TaskManager/internal/domain/model"
+	"context"
+	"errors"
+	"fmt"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"time"
+)
+
+// BoardMongo stores kanban boards and their columns in MongoDB.
+type BoardMongo struct {
+	collection *mongo.Collection
+	workspaces *mongo.Collection
+}
+
+// NewBoardMongo initializes a new BoardMongo instance with the provided MongoDB client and database name.
+func NewBoardMongo(client *mongo.Client, dbName string) *BoardMongo {
+	db := client.Database(dbName)
+	return &BoardMongo{
+		collection: db.Collection("boards"),
+		workspaces: db.Collection("workspaces"),
+	}
+}
+
+// Create inserts a new board and returns its ID.
+func (b *BoardMongo) Create(board model.Board) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	board.Id = bson.NewObjectID()
+	if _, err := b.collection.InsertOne(ctx, board); err != nil {
+		return "", fmt.Errorf("error inserting board: %w", err)
+	}
+	return board.Id.Hex(), nil
+}
+
+// GetAll retrieves the boards the user created or can reach through workspace membership.
+func (b *BoardMongo) GetAll(userId string) ([]model.Board, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	filter, err := workspaceAccessFilter(ctx, b.workspaces, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := b.collection.Find(ctx, filter, options.Find().SetSort(bson.M{"name": 1}))
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving boards: %w", err)
+	}
+
+	boards := []model.Board{}
+	if err := cursor.All(ctx, &boards); err != nil {
+		return nil, fmt.Errorf("error decoding boards: %w", err)
+	}
+	return boards, nil
+}
+
+// GetById retrieves a board the user can access.
+func (b *BoardMongo) GetById(userId string, id string) (model.Board, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	objectId, err := bson.ObjectIDFromHex(id)
+	if err != nil {
+		return model.Board{}, fmt.Errorf("invalid board id: %w", err)
+	}
+	filter, err := workspaceAccessFilter(ctx, b.workspaces, userId)
+	if err != nil {
+		return model.Board{}, err
+	}
+	filter["_id"] = objectId
+
+	var board model.Board
+	if err := b.collection.FindOne(ctx, filter).Decode(&board); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return model.Board{}, fmt.Errorf("board %s not found for user %s", id, userId)
+		}
+		return model.Board{}, fmt.Errorf("error retrieving board: %w", err)
+	}
+	return board, nil
+}
+
+// Delete removes a board.
+func (b *BoardMongo) Delete(id string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	objectId, err := bson.ObjectIDFromHex(id)
+	if err != nil {
+		return fmt.Errorf("invalid board id: %w", err)
+	}
+
+	res, err := b.collection.DeleteOne(ctx, bson.M{"_id": objectId})
+	if err != nil {
+		return fmt.Errorf("error deleting board: %w", err)
+	}
+	if res.DeletedCount == 0 {
+		return fmt.Errorf("board %s not found", id)
+	}
+	return nil
+}
+
+// AddColumn appends a column to a board.
+func (b *BoardMongo) AddColumn(id string, column model.BoardColumn) error {
+	return b.updateOne(id, bson.M{"$push": bson.M{"columns": column}})
+}
+
+// RemoveColumn removes a column from a board.
+func (b *BoardMongo) RemoveColumn(id string, columnId string) error {
+	return b.updateOne(id, bson.M{"$pull": bson.M{"columns": bson.M{"id": columnId}}})
+}
+
+// updateOne applies the update to a board.
+func (b *BoardMongo) updateOne(id string, update bson.M) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	objectId, err := bson.ObjectIDFromHex(id)
+	if err != nil {
+		return fmt.Errorf("invalid board id: %w", err)
+	}
+
+	res, err := b.collection.UpdateOne(ctx, bson.M{"_id": objectId}, update)
+	if err != nil {
+		return fmt.Errorf("error updating board: %w", err)
+	}
+	if res.MatchedCount == 0 {
+		return fmt.Errorf("board %s not found", id)
+	}
+	return nil
+}
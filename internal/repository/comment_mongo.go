@@ -0,0 +1,218 @@
+package repository
+
+import (
+	"TaskManager/internal/domain/model"
+	"context"
+	"errors"
+	"fmt"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"time"
+)
+
+// CommentMongo stores task list comments and the mentions made in them in MongoDB.
+type CommentMongo struct {
+	collection *mongo.Collection
+	mentions   *mongo.Collection
+}
+
+// NewCommentMongo initializes a new CommentMongo instance with the provided MongoDB client and database name.
+func NewCommentMongo(client *mongo.Client, dbName string) *CommentMongo {
+	db := client.Database(dbName)
+	return &CommentMongo{
+		collection: db.Collection("comments"),
+		mentions:   db.Collection("mentions"),
+	}
+}
+
+// Create inserts a new comment and returns its ID.
+func (c *CommentMongo) Create(comment model.Comment) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	comment.Id = bson.NewObjectID()
+	if _, err := c.collection.InsertOne(ctx, comment); err != nil {
+		return "", fmt.Errorf("error inserting comment: %w", err)
+	}
+	return comment.Id.Hex(), nil
+}
+
+// GetById retrieves a comment of a task list.
+func (c *CommentMongo) GetById(listId int, id string) (model.Comment, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	objectId, err := bson.ObjectIDFromHex(id)
+	if err != nil {
+		return model.Comment{}, fmt.Errorf("invalid comment id: %w", err)
+	}
+
+	var comment model.Comment
+	err = c.collection.FindOne(ctx, bson.M{"_id": objectId, "list_id": listId}).Decode(&comment)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return model.Comment{}, fmt.Errorf("comment %s not found on task list %d", id, listId)
+		}
+		return model.Comment{}, fmt.Errorf("error retrieving comment: %w", err)
+	}
+	return comment, nil
+}
+
+// GetThreads retrieves a page of the top-level comments of a task list, oldest first,
+// together with the total number of top-level comments.
+func (c *CommentMongo) GetThreads(listId int, page model.Page) ([]model.Comment, int64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	filter := bson.M{"list_id": listId, "parent_id": bson.M{"$exists": false}}
+	total, err := c.collection.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("error counting comments: %w", err)
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: 1}, {Key: "_id", Value: 1}}).
+		SetSkip(int64(page.Offset)).
+		SetLimit(int64(page.Limit))
+	cursor, err := c.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, 0, fmt.Errorf("error retrieving comments: %w", err)
+	}
+
+	comments := []model.Comment{}
+	if err := cursor.All(ctx, &comments); err != nil {
+		return nil, 0, fmt.Errorf("error decoding comments: %w", err)
+	}
+	return comments, total, nil
+}
+
+// GetReplies retrieves the replies to the given top-level comments, oldest first.
+func (c *CommentMongo) GetReplies(listId int, parentIds []string) ([]model.Comment, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	replies := []model.Comment{}
+	if len(parentIds) == 0 {
+		return replies, nil
+	}
+
+	filter := bson.M{"list_id": listId, "parent_id": bson.M{"$in": parentIds}}
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}, {Key: "_id", Value: 1}})
+	cursor, err := c.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving replies: %w", err)
+	}
+	if err := cursor.All(ctx, &replies); err != nil {
+		return nil, fmt.Errorf("error decoding replies: %w", err)
+	}
+	return replies, nil
+}
+
+// Update replaces the body and mentions of a comment.
+func (c *CommentMongo) Update(id string, body string, mentions []string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	objectId, err := bson.ObjectIDFromHex(id)
+	if err != nil {
+		return fmt.Errorf("invalid comment id: %w", err)
+	}
+
+	update := bson.M{"$set": bson.M{"body": body, "mentions": mentions, "updated_at": time.Now()}}
+	res, err := c.collection.UpdateOne(ctx, bson.M{"_id": objectId}, update)
+	if err != nil {
+		return fmt.Errorf("error updating comment: %w", err)
+	}
+	if res.MatchedCount == 0 {
+		return fmt.Errorf("comment %s not found", id)
+	}
+	return nil
+}
+
+// Delete removes a comment together with its replies.
+func (c *CommentMongo) Delete(id string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	objectId, err := bson.ObjectIDFromHex(id)
+	if err != nil {
+		return fmt.Errorf("invalid comment id: %w", err)
+	}
+
+	filter := bson.M{"$or": bson.A{bson.M{"_id": objectId}, bson.M{"parent_id": id}}}
+	res, err := c.collection.DeleteMany(ctx, filter)
+	if err != nil {
+		return fmt.Errorf("error deleting comment: %w", err)
+	}
+	if res.DeletedCount == 0 {
+		return fmt.Errorf("comment %s not found", id)
+	}
+	return nil
+}
+
+// CreateMentions records mention notifications.
+func (c *CommentMongo) CreateMentions(mentions []model.Mention) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if len(mentions) == 0 {
+		return nil
+	}
+	docs := make([]interface{}, 0, len(mentions))
+	for _, mention := range mentions {
+		mention.Id = bson.NewObjectID()
+		docs = append(docs, mention)
+	}
+	if _, err := c.mentions.InsertMany(ctx, docs); err != nil {
+		return fmt.Errorf("error inserting mentions: %w", err)
+	}
+	return nil
+}
+
+// GetMentions retrieves a page of the mentions of a user, newest first, together with their total number.
+func (c *CommentMongo) GetMentions(userId string, page model.Page) ([]model.Mention, int64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	filter := bson.M{"user_id": userId}
+	total, err := c.mentions.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("error counting mentions: %w", err)
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: -1}, {Key: "_id", Value: -1}}).
+		SetSkip(int64(page.Offset)).
+		SetLimit(int64(page.Limit))
+	cursor, err := c.mentions.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, 0, fmt.Errorf("error retrieving mentions: %w", err)
+	}
+
+	mentions := []model.Mention{}
+	if err := cursor.All(ctx, &mentions); err != nil {
+		return nil, 0, fmt.Errorf("error decoding mentions: %w", err)
+	}
+	return mentions, total, nil
+}
+
+// EnsureIndexes creates the indexes used to page through comment threads and mentions.
+func (c *CommentMongo) EnsureIndexes() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	_, err := c.collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "list_id", Value: 1}, {Key: "parent_id", Value: 1}, {Key: "created_at", Value: 1}}},
+	})
+	if err != nil {
+		return fmt.Errorf("error creating comment indexes: %w", err)
+	}
+	_, err = c.mentions.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "created_at", Value: -1}},
+	})
+	if err != nil {
+		return fmt.Errorf("error creating mention indexes: %w", err)
+	}
+	return nil
+}
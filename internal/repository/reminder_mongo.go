@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"TaskManager/internal/domain/model"
+	"context"
+	"errors"
+	"fmt"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"time"
+)
+
+// ReminderMongo stores reminder delivery attempts in MongoDB.
+type ReminderMongo struct {
+	collection *mongo.Collection
+}
+
+// NewReminderMongo initializes a new ReminderMongo instance with the provided MongoDB client and database name.
+func NewReminderMongo(client *mongo.Client, dbName string) *ReminderMongo {
+	return &ReminderMongo{
+		collection: client.Database(dbName).Collection("reminder_deliveries"),
+	}
+}
+
+// GetDelivery returns the delivery record of a reminder, or a zero record when it has not been attempted yet.
+func (r *ReminderMongo) GetDelivery(listId int, dueDate time.Time, offset int, channel string) (model.ReminderDelivery, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	filter := bson.M{"list_id": listId, "due_date": dueDate, "offset": offset, "channel": channel}
+	var delivery model.ReminderDelivery
+	err := r.collection.FindOne(ctx, filter).Decode(&delivery)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return model.ReminderDelivery{}, nil
+		}
+		return model.ReminderDelivery{}, fmt.Errorf("error retrieving reminder delivery: %w", err)
+	}
+	return delivery, nil
+}
+
+// SaveDelivery inserts or replaces the delivery record of a reminder.
+func (r *ReminderMongo) SaveDelivery(delivery model.ReminderDelivery) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	filter := bson.M{
+		"list_id":  delivery.ListId,
+		"due_date": delivery.DueDate,
+		"offset":   delivery.Offset,
+		"channel":  delivery.Channel,
+	}
+	delivery.Id = bson.ObjectID{}
+	delivery.UpdatedAt = time.Now()
+	_, err := r.collection.ReplaceOne(ctx, filter, delivery, options.Replace().SetUpsert(true))
+	if err != nil {
+		return fmt.Errorf("error saving reminder delivery: %w", err)
+	}
+	return nil
+}
@@ -3,27 +3,172 @@ package repository
 import (
 	"TaskManager/internal/domain/model"
 	"go.mongodb.org/mongo-driver/v2/mongo"
+	"time"
 )
 
 // Authorization defines the interface for user authentication and authorization operations.
 type Authorization interface {
 	CreateUser(user model.User) (int, error)
 	GetUser(username, password string) (model.User, error)
+	GetUserById(id string) (model.User, error)
+	GetUsersByIds(ids []string) ([]model.User, error)
+	GetUserByUsername(username string) (model.User, error)
+	SetPassword(id string, passwordHash string) error
+	SetDisabled(id string, disabled bool) error
+	GetUserByIdentity(identity model.ExternalIdentity) (model.User, error)
+	GetUserByEmail(email string) (model.User, error)
+	AddIdentity(id string, identity model.ExternalIdentity) error
+	SetTwoFactor(id string, twoFactor model.TwoFactor) error
+	RemoveTwoFactor(id string) error
+	AcceptTwoFactorStep(id string, step int64, maxFailures int) (bool, error)
+	UseRecoveryCode(id string, hash string) (bool, error)
+	RecordTwoFactorFailure(id string, maxFailures int) error
+	EnsureIndexes() error
 }
 
 // TaskList defines the interface for task list operations.
 type TaskList interface {
 	Create(userId string, list model.TaskList) (int, error)
-	GetAll(userId string) ([]model.TaskList, error)
+	GetAll(userId string, filter model.TaskListFilter) ([]model.TaskList, error)
 	GetById(userId string, listId int) (model.TaskList, error)
 	Delete(userId string, listId int) error
 	Update(userId string, listId int, input model.UpdateTaskListInput) error
+	AddTags(userId string, listId int, tags []string) error
+	RemoveTag(userId string, listId int, tag string) error
+	GetTags(userId string) ([]model.TagCount, error)
+	GetDueRecurring(before time.Time) ([]model.TaskList, error)
+	SetRecurrence(userId string, listId int, rec *model.Recurrence) error
+	GetSeriesHead(userId string, seriesId int) (model.TaskList, error)
+	GetUpcoming(from, to time.Time) ([]model.TaskList, error)
+	DetachWorkspace(workspaceId string) error
+	Move(userId string, listId int, boardId, columnId, position, workspaceId string) error
+	GetByBoard(userId string, boardId string) ([]model.TaskList, error)
+	DetachBoard(boardId string, columnId string) error
+	GetByIds(userId string, listIds []int) ([]model.TaskList, error)
+	GetDependencyNodes(listIds []int) ([]model.TaskList, error)
+	GetDependents(userId string, listId int) ([]model.TaskList, error)
+	AddDependency(userId string, listId int, dependsOnId int) error
+	RemoveDependency(userId string, listId int, dependsOnId int) error
+	DetachDependency(userId string, listId int) error
+	SetItems(userId string, listId int, items []model.TaskItem) error
+	Reassign(fromUserId, toUserId string, listIds []int) (int64, error)
+	ReseedCounter() (int, error)
+	EnsureIndexes() error
+}
+
+// Board defines the interface for kanban board operations.
+type Board interface {
+	Create(board model.Board) (string, error)
+	GetAll(userId string) ([]model.Board, error)
+	GetById(userId string, id string) (model.Board, error)
+	Delete(id string) error
+	AddColumn(id string, column model.BoardColumn) error
+	RemoveColumn(id string, columnId string) error
+}
+
+// Workspace defines the interface for workspace and membership operations.
+type Workspace interface {
+	Create(workspace model.Workspace) (string, error)
+	GetAll(userId string) ([]model.Workspace, error)
+	GetById(userId string, id string) (model.Workspace, error)
+	Update(id string, name string) error
+	Delete(id string) error
+	AddMember(id string, member model.WorkspaceMember) error
+	UpdateMember(id string, userId string, role string) error
+	RemoveMember(id string, userId string) error
+	EnsureIndexes() error
+}
+
+// Reminder defines the interface for recording reminder delivery attempts.
+type Reminder interface {
+	GetDelivery(listId int, dueDate time.Time, offset int, channel string) (model.ReminderDelivery, error)
+	SaveDelivery(delivery model.ReminderDelivery) error
+}
+
+// Webhook defines the interface for webhook subscriptions and their delivery log.
+type Webhook interface {
+	Create(userId string, hook model.Webhook) (string, error)
+	GetAll(userId string) ([]model.Webhook, error)
+	GetByEvent(userId string, event string) ([]model.Webhook, error)
+	GetById(userId string, id string) (model.Webhook, error)
+	Delete(userId string, id string) error
+	CreateDelivery(delivery model.WebhookDelivery) error
+	UpdateDelivery(delivery model.WebhookDelivery) error
+	GetPendingDeliveries(before time.Time) ([]model.WebhookDelivery, error)
+	GetDeliveries(userId string, webhookId string) ([]model.WebhookDelivery, error)
+}
+
+// Comment defines the interface for task list comments and mention notifications.
+type Comment interface {
+	Create(comment model.Comment) (string, error)
+	GetById(listId int, id string) (model.Comment, error)
+	GetThreads(listId int, page model.Page) ([]model.Comment, int64, error)
+	GetReplies(listId int, parentIds []string) ([]model.Comment, error)
+	Update(id string, body string, mentions []string) error
+	Delete(id string) error
+	CreateMentions(mentions []model.Mention) error
+	GetMentions(userId string, page model.Page) ([]model.Mention, int64, error)
+	EnsureIndexes() error
+}
+
+// Attachment defines the interface for the metadata of task list attachments.
+type Attachment interface {
+	Create(attachment model.Attachment) (string, error)
+	GetAll(listId int) ([]model.Attachment, error)
+	GetById(listId int, id string) (model.Attachment, error)
+	Delete(id string) error
+	EnsureIndexes() error
+}
+
+// TimeEntry defines the interface for tracked time.
+type TimeEntry interface {
+	Create(entry model.TimeEntry) (string, error)
+	GetRunning(userId string) (model.TimeEntry, error)
+	Stop(id string, end time.Time, seconds int64) error
+	GetByList(listId int) ([]model.TimeEntry, error)
+	GetInRange(userId string, from, to time.Time) ([]model.TimeEntry, error)
+	Delete(userId string, listId int, id string) error
+	EnsureIndexes() error
+}
+
+// Template defines the interface for task list templates.
+type Template interface {
+	Create(template model.Template) (string, error)
+	GetAll(userId string) ([]model.Template, error)
+	GetById(userId string, id string) (model.Template, error)
+	Update(userId string, id string, template model.Template) error
+	Delete(userId string, id string) error
+}
+
+// AccessToken defines the interface for personal access tokens.
+type AccessToken interface {
+	Create(token model.AccessToken) (string, error)
+	GetAll(userId string) ([]model.AccessToken, error)
+	GetByHash(hash string) (model.AccessToken, error)
+	Revoke(userId string, id string, at time.Time) error
+	SetLastUsed(id string, at time.Time) error
+	EnsureIndexes() error
+}
+
+// TaskListStats is implemented by task list backends that can aggregate a user's statistics themselves.
+// For backends without it the service computes the statistics from the task lists.
+type TaskListStats interface {
+	GetStats(userId string, since, now time.Time) (model.Stats, error)
 }
 
 // Repository defines the interface for interacting with the data layer.
 type Repository struct {
 	Authorization
 	TaskList
+	Reminder
+	Webhook
+	Workspace
+	Board
+	Comment
+	Attachment
+	TimeEntry
+	Template
+	AccessToken
 }
 
 // NewRepository initializes a new Repository instance with MongoDB implementations.
@@ -31,5 +176,37 @@ func NewRepository(client *mongo.Client, dbName string) *Repository {
 	return &Repository{
 		Authorization: NewAuthMongo(client, dbName),
 		TaskList:      NewTaskListMongo(client, dbName),
+		Reminder:      NewReminderMongo(client, dbName),
+		Webhook:       NewWebhookMongo(client, dbName),
+		Workspace:     NewWorkspaceMongo(client, dbName),
+		Board:         NewBoardMongo(client, dbName),
+		Comment:       NewCommentMongo(client, dbName),
+		Attachment:    NewAttachmentMongo(client, dbName),
+		TimeEntry:     NewTimeEntryMongo(client, dbName),
+		Template:      NewTemplateMongo(client, dbName),
+		AccessToken:   NewAccessTokenMongo(client, dbName),
+	}
+}
+
+// EnsureIndexes creates the indexes the repositories rely on.
+func (r *Repository) EnsureIndexes() error {
+	if err := r.Authorization.EnsureIndexes(); err != nil {
+		return err
+	}
+	if err := r.TaskList.EnsureIndexes(); err != nil {
+		return err
+	}
+	if err := r.Workspace.EnsureIndexes(); err != nil {
+		return err
+	}
+	if err := r.Comment.EnsureIndexes(); err != nil {
+		return err
+	}
+	if err := r.Attachment.EnsureIndexes(); err != nil {
+		return err
+	}
+	if err := r.AccessToken.EnsureIndexes(); err != nil {
+		return err
 	}
+	return r.TimeEntry.EnsureIndexes()
 }
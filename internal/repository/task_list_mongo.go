@@ -11,15 +11,28 @@ import (
 	"time"
 )
 
-// Authorization defines the interface for user authentication and authorization operations.
+// ErrTaskListNotFound is returned when no task list the user can access has the requested ID.
+var ErrTaskListNotFound = errors.New("task list not found")
+
+// taskListCounterId is the document of the counters collection that holds the last task list ID.
+const taskListCounterId = "task_list_id"
+
+// TaskListMongo stores task lists in MongoDB.
 type TaskListMongo struct {
-	collection *mongo.Collection
+	collection  *mongo.Collection
+	workspaces  *mongo.Collection
+	comments    *mongo.Collection
+	attachments *mongo.Collection
 }
 
 // NewTaskListMongo initializes a new TaskListMongo instance with the provided MongoDB client and database name.
 func NewTaskListMongo(client *mongo.Client, dbName string) *TaskListMongo {
+	db := client.Database(dbName)
 	return &TaskListMongo{
-		collection: client.Database(dbName).Collection("task_lists"),
+		collection:  db.Collection("task_lists"),
+		workspaces:  db.Collection("workspaces"),
+		comments:    db.Collection("comments"),
+		attachments: db.Collection("attachments"),
 	}
 }
 
@@ -30,7 +43,7 @@ func (t *TaskListMongo) Create(userId string, list model.TaskList) (int, error)
 
 	counterColl := t.collection.Database().Collection("counters")
 	var result struct{ Seq int }
-	filter := bson.M{"_id": "task_list_id"}
+	filter := bson.M{"_id": taskListCounterId}
 	update := bson.M{"$inc": bson.M{"seq": 1}}
 	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
 	err := counterColl.FindOneAndUpdate(ctx, filter, update, opts).Decode(&result)
@@ -38,8 +51,10 @@ func (t *TaskListMongo) Create(userId string, list model.TaskList) (int, error)
 		return 0, err
 	}
 
+	now := time.Now()
 	list.Id = result.Seq
 	list.UserId = userId
+	list.CreatedAt = &now
 	_, err = t.collection.InsertOne(ctx, list)
 	if err != nil {
 		return 0, err
@@ -49,27 +64,32 @@ func (t *TaskListMongo) Create(userId string, list model.TaskList) (int, error)
 }
 
 // GetAll implements the TaskList interface for retrieving all task lists for a user from MongoDB.
-func (t *TaskListMongo) GetAll(userId string) ([]model.TaskList, error) {
+func (t *TaskListMongo) GetAll(userId string, listFilter model.TaskListFilter) ([]model.TaskList, error) {
 	// Implementation for retrieving all task lists for a user
 
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
-	filter := bson.M{"user_id": userId}
-	cursor, err := t.collection.Find(ctx, filter)
+	filter, err := t.accessFilter(ctx, userId)
 	if err != nil {
-		if errors.Is(err, mongo.ErrNoDocuments) {
-			return nil, fmt.Errorf("no task lists found for user %s", userId)
+		return nil, err
+	}
+	if len(listFilter.Tags) > 0 {
+		if listFilter.MatchAllTags {
+			filter["tags"] = bson.M{"$all": listFilter.Tags}
+		} else {
+			filter["tags"] = bson.M{"$in": listFilter.Tags}
 		}
+	}
+	cursor, err := t.collection.Find(ctx, filter)
+	if err != nil {
 		return nil, fmt.Errorf("error retrieving task lists: %w", err)
 	}
 
-	var taskLists []model.TaskList
+	// An empty result is a valid answer, returned as an empty slice so it encodes as [].
+	taskLists := []model.TaskList{}
 	if err := cursor.All(ctx, &taskLists); err != nil {
 		return nil, fmt.Errorf("error decoding task lists: %w", err)
 	}
-	if len(taskLists) == 0 {
-		return nil, fmt.Errorf("no task lists found for user %s", userId)
-	}
 	return taskLists, nil
 }
 
@@ -78,18 +98,20 @@ func (t *TaskListMongo) GetById(userId string, listId int) (model.TaskList, erro
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
-	filter := bson.M{"user_id": userId, "id": listId}
+	filter, err := t.accessFilter(ctx, userId)
+	if err != nil {
+		return model.TaskList{}, err
+	}
+	filter["id"] = listId
+
 	var taskList model.TaskList
-	err := t.collection.FindOne(ctx, filter).Decode(&taskList)
+	err = t.collection.FindOne(ctx, filter).Decode(&taskList)
 	if err != nil {
 		if errors.Is(err, mongo.ErrNoDocuments) {
 			return model.TaskList{}, fmt.Errorf("task list with ID %d not found for user %s", listId, userId)
 		}
 		return model.TaskList{}, fmt.Errorf("error retrieving task list: %w", err)
 	}
-	if taskList.UserId != userId {
-		return model.TaskList{}, fmt.Errorf("task list with ID %d does not belong to user %s", listId, userId)
-	}
 	return taskList, nil
 }
 
@@ -98,14 +120,28 @@ func (t *TaskListMongo) Delete(userId string, listId int) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
-	filter := bson.M{"user_id": userId, "id": listId}
-	_, err := t.collection.DeleteOne(ctx, filter)
+	filter, err := t.accessFilter(ctx, userId)
 	if err != nil {
-		if errors.Is(err, mongo.ErrNoDocuments) {
-			return fmt.Errorf("task list with ID %d not found for user %s", listId, userId)
-		}
+		return err
 	}
+	filter["id"] = listId
 
+	res, err := t.collection.DeleteOne(ctx, filter)
+	if err != nil {
+		return fmt.Errorf("error deleting task list: %w", err)
+	}
+	if res.DeletedCount == 0 {
+		return fmt.Errorf("task list with ID %d not found for user %s: %w", listId, userId, ErrTaskListNotFound)
+	}
+
+	// The discussion and the attachment records of a list go with it.
+	// The attachment files are removed from the blob store by the attachment service.
+	if _, err := t.comments.DeleteMany(ctx, bson.M{"list_id": listId}); err != nil {
+		return fmt.Errorf("error deleting task list comments: %w", err)
+	}
+	if _, err := t.attachments.DeleteMany(ctx, bson.M{"list_id": listId}); err != nil {
+		return fmt.Errorf("error deleting task list attachments: %w", err)
+	}
 	return nil
 }
 
@@ -114,7 +150,12 @@ func (t *TaskListMongo) Update(userId string, listId int, input model.UpdateTask
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
-	filter := bson.M{"user_id": userId, "id": listId}
+	filter, err := t.accessFilter(ctx, userId)
+	if err != nil {
+		return err
+	}
+	filter["id"] = listId
+
 	update := bson.M{}
 	if input.Title != nil {
 		update["title"] = *input.Title
@@ -122,10 +163,42 @@ func (t *TaskListMongo) Update(userId string, listId int, input model.UpdateTask
 	if input.Description != nil {
 		update["description"] = *input.Description
 	}
-	if len(update) == 0 {
+	if input.Status != nil {
+		update["status"] = *input.Status
+		if *input.Status == model.StatusDone {
+			update["completed_at"] = time.Now()
+		} else {
+			update["completed_at"] = nil
+		}
+	}
+	if input.Priority != nil {
+		update["priority"] = *input.Priority
+	}
+	if input.DueDate != nil {
+		update["due_date"] = *input.DueDate
+	}
+	if input.ReminderOffsets != nil {
+		update["reminder_offsets"] = *input.ReminderOffsets
+	}
+	unset := bson.M{}
+	if input.WorkspaceId != nil {
+		if *input.WorkspaceId == "" {
+			unset["workspace_id"] = ""
+		} else {
+			update["workspace_id"] = *input.WorkspaceId
+		}
+	}
+	if len(update) == 0 && len(unset) == 0 {
 		return errors.New("no fields to update")
 	}
-	_, err := t.collection.UpdateOne(ctx, filter, bson.M{"$set": update})
+	changes := bson.M{}
+	if len(update) > 0 {
+		changes["$set"] = update
+	}
+	if len(unset) > 0 {
+		changes["$unset"] = unset
+	}
+	_, err = t.collection.UpdateOne(ctx, filter, changes)
 	if err != nil {
 		if errors.Is(err, mongo.ErrNoDocuments) {
 			return fmt.Errorf("task list with ID %d not found for user %s", listId, userId)
@@ -134,3 +207,578 @@ func (t *TaskListMongo) Update(userId string, listId int, input model.UpdateTask
 	}
 	return nil
 }
+
+// GetDueRecurring returns the heads of recurrence series whose due date is at or before the given time.
+func (t *TaskListMongo) GetDueRecurring(before time.Time) ([]model.TaskList, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	filter := bson.M{
+		"recurrence":         bson.M{"$ne": nil},
+		"recurrence.next_id": 0,
+		"due_date":           bson.M{"$lte": before},
+	}
+	cursor, err := t.collection.Find(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving recurring task lists: %w", err)
+	}
+
+	var taskLists []model.TaskList
+	if err := cursor.All(ctx, &taskLists); err != nil {
+		return nil, fmt.Errorf("error decoding recurring task lists: %w", err)
+	}
+	return taskLists, nil
+}
+
+// SetRecurrence replaces the recurrence of a task list, removing it when rec is nil.
+func (t *TaskListMongo) SetRecurrence(userId string, listId int, rec *model.Recurrence) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	filter, err := t.accessFilter(ctx, userId)
+	if err != nil {
+		return err
+	}
+	filter["id"] = listId
+
+	update := bson.M{"$unset": bson.M{"recurrence": ""}}
+	if rec != nil {
+		update = bson.M{"$set": bson.M{"recurrence": rec}}
+	}
+	res, err := t.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return fmt.Errorf("error updating task list recurrence: %w", err)
+	}
+	if res.MatchedCount == 0 {
+		return fmt.Errorf("task list with ID %d not found for user %s", listId, userId)
+	}
+	return nil
+}
+
+// GetSeriesHead returns the latest occurrence of a recurrence series, the one that has not been materialized yet.
+func (t *TaskListMongo) GetSeriesHead(userId string, seriesId int) (model.TaskList, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	filter, err := t.accessFilter(ctx, userId)
+	if err != nil {
+		return model.TaskList{}, err
+	}
+	filter["recurrence.series_id"] = seriesId
+	filter["recurrence.next_id"] = 0
+
+	var taskList model.TaskList
+	err = t.collection.FindOne(ctx, filter).Decode(&taskList)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return model.TaskList{}, fmt.Errorf("recurrence series %d is not active for user %s", seriesId, userId)
+		}
+		return model.TaskList{}, fmt.Errorf("error retrieving recurrence series: %w", err)
+	}
+	return taskList, nil
+}
+
+// GetUpcoming returns the open task lists with reminders whose due date falls within the given range.
+func (t *TaskListMongo) GetUpcoming(from, to time.Time) ([]model.TaskList, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	filter := bson.M{
+		"status":             bson.M{"$ne": model.StatusDone},
+		"due_date":           bson.M{"$gte": from, "$lte": to},
+		"reminder_offsets.0": bson.M{"$exists": true},
+	}
+	cursor, err := t.collection.Find(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving upcoming task lists: %w", err)
+	}
+
+	var taskLists []model.TaskList
+	if err := cursor.All(ctx, &taskLists); err != nil {
+		return nil, fmt.Errorf("error decoding upcoming task lists: %w", err)
+	}
+	return taskLists, nil
+}
+
+// AddTags adds tags to a task list, ignoring the ones it already carries.
+func (t *TaskListMongo) AddTags(userId string, listId int, tags []string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	filter, err := t.accessFilter(ctx, userId)
+	if err != nil {
+		return err
+	}
+	filter["id"] = listId
+
+	update := bson.M{"$addToSet": bson.M{"tags": bson.M{"$each": tags}}}
+	res, err := t.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return fmt.Errorf("error adding task list tags: %w", err)
+	}
+	if res.MatchedCount == 0 {
+		return fmt.Errorf("task list with ID %d not found for user %s", listId, userId)
+	}
+	return nil
+}
+
+// RemoveTag removes a tag from a task list.
+func (t *TaskListMongo) RemoveTag(userId string, listId int, tag string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	filter, err := t.accessFilter(ctx, userId)
+	if err != nil {
+		return err
+	}
+	filter["id"] = listId
+
+	update := bson.M{"$pull": bson.M{"tags": tag}}
+	res, err := t.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return fmt.Errorf("error removing task list tag: %w", err)
+	}
+	if res.MatchedCount == 0 {
+		return fmt.Errorf("task list with ID %d not found for user %s", listId, userId)
+	}
+	return nil
+}
+
+// GetTags returns the user's tags with the number of task lists carrying each, most used first.
+func (t *TaskListMongo) GetTags(userId string) ([]model.TagCount, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	filter, err := t.accessFilter(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: filter}},
+		{{Key: "$unwind", Value: "$tags"}},
+		{{Key: "$group", Value: bson.M{"_id": "$tags", "count": bson.M{"$sum": 1}}}},
+		{{Key: "$sort", Value: bson.D{{Key: "count", Value: -1}, {Key: "_id", Value: 1}}}},
+	}
+	cursor, err := t.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("error aggregating tags: %w", err)
+	}
+
+	tags := []model.TagCount{}
+	if err := cursor.All(ctx, &tags); err != nil {
+		return nil, fmt.Errorf("error decoding tags: %w", err)
+	}
+	return tags, nil
+}
+
+// EnsureIndexes creates the indexes used by task list queries.
+// The index on tags is a multikey index, so tag filters do not scan every list of the user.
+func (t *TaskListMongo) EnsureIndexes() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	_, err := t.collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "id", Value: 1}}},
+		{Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "tags", Value: 1}}},
+		{Keys: bson.D{{Key: "workspace_id", Value: 1}, {Key: "id", Value: 1}}},
+		{Keys: bson.D{{Key: "board_id", Value: 1}, {Key: "column_id", Value: 1}, {Key: "position", Value: 1}}},
+		{Keys: bson.D{{Key: "depends_on", Value: 1}}},
+	})
+	if err != nil {
+		return fmt.Errorf("error creating task list indexes: %w", err)
+	}
+	return nil
+}
+
+// DetachWorkspace moves every task list of a workspace back to the user who created it.
+func (t *TaskListMongo) DetachWorkspace(workspaceId string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	filter := bson.M{"workspace_id": workspaceId}
+	_, err := t.collection.UpdateMany(ctx, filter, bson.M{"$unset": bson.M{"workspace_id": ""}})
+	if err != nil {
+		return fmt.Errorf("error detaching task lists from workspace: %w", err)
+	}
+	return nil
+}
+
+// Move places a task list in a board column at the given position in a single update,
+// also moving it into the board's workspace when one is given.
+func (t *TaskListMongo) Move(userId string, listId int, boardId, columnId, position, workspaceId string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	filter, err := t.accessFilter(ctx, userId)
+	if err != nil {
+		return err
+	}
+	filter["id"] = listId
+
+	set := bson.M{"board_id": boardId, "column_id": columnId, "position": position}
+	if workspaceId != "" {
+		set["workspace_id"] = workspaceId
+	}
+	res, err := t.collection.UpdateOne(ctx, filter, bson.M{"$set": set})
+	if err != nil {
+		return fmt.Errorf("error moving task list: %w", err)
+	}
+	if res.MatchedCount == 0 {
+		return fmt.Errorf("task list with ID %d not found for user %s", listId, userId)
+	}
+	return nil
+}
+
+// GetByBoard returns the task lists on a board the user can access, ordered by position.
+func (t *TaskListMongo) GetByBoard(userId string, boardId string) ([]model.TaskList, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	filter, err := t.accessFilter(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	filter["board_id"] = boardId
+
+	cursor, err := t.collection.Find(ctx, filter, options.Find().SetSort(bson.M{"position": 1}))
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving board task lists: %w", err)
+	}
+
+	taskLists := []model.TaskList{}
+	if err := cursor.All(ctx, &taskLists); err != nil {
+		return nil, fmt.Errorf("error decoding board task lists: %w", err)
+	}
+	return taskLists, nil
+}
+
+// DetachBoard takes the task lists off a board column, or off the whole board when columnId is empty.
+func (t *TaskListMongo) DetachBoard(boardId string, columnId string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	filter := bson.M{"board_id": boardId}
+	if columnId != "" {
+		filter["column_id"] = columnId
+	}
+	update := bson.M{"$unset": bson.M{"board_id": "", "column_id": "", "position": ""}}
+	if _, err := t.collection.UpdateMany(ctx, filter, update); err != nil {
+		return fmt.Errorf("error detaching task lists from board: %w", err)
+	}
+	return nil
+}
+
+// GetByIds returns the task lists with the given IDs that the user can access, ordered by ID.
+// IDs the user cannot access are left out.
+func (t *TaskListMongo) GetByIds(userId string, listIds []int) ([]model.TaskList, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	taskLists := []model.TaskList{}
+	if len(listIds) == 0 {
+		return taskLists, nil
+	}
+
+	filter, err := t.accessFilter(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	filter["id"] = bson.M{"$in": listIds}
+
+	cursor, err := t.collection.Find(ctx, filter, options.Find().SetSort(bson.M{"id": 1}))
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving task lists: %w", err)
+	}
+	if err := cursor.All(ctx, &taskLists); err != nil {
+		return nil, fmt.Errorf("error decoding task lists: %w", err)
+	}
+	return taskLists, nil
+}
+
+// GetDependencyNodes returns the ID, status and dependencies of the task lists with the given IDs,
+// whoever can access them, so dependency checks see the whole graph.
+// The lists are not meant to be shown to the user.
+func (t *TaskListMongo) GetDependencyNodes(listIds []int) ([]model.TaskList, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	taskLists := []model.TaskList{}
+	if len(listIds) == 0 {
+		return taskLists, nil
+	}
+
+	opts := options.Find().SetProjection(bson.M{"id": 1, "status": 1, "depends_on": 1}).SetSort(bson.M{"id": 1})
+	cursor, err := t.collection.Find(ctx, bson.M{"id": bson.M{"$in": listIds}}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving task list dependencies: %w", err)
+	}
+	if err := cursor.All(ctx, &taskLists); err != nil {
+		return nil, fmt.Errorf("error decoding task list dependencies: %w", err)
+	}
+	return taskLists, nil
+}
+
+// GetDependents returns the task lists the user can access that depend on the given one, ordered by ID.
+func (t *TaskListMongo) GetDependents(userId string, listId int) ([]model.TaskList, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	filter, err := t.accessFilter(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	filter["depends_on"] = listId
+
+	cursor, err := t.collection.Find(ctx, filter, options.Find().SetSort(bson.M{"id": 1}))
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving dependent task lists: %w", err)
+	}
+
+	taskLists := []model.TaskList{}
+	if err := cursor.All(ctx, &taskLists); err != nil {
+		return nil, fmt.Errorf("error decoding dependent task lists: %w", err)
+	}
+	return taskLists, nil
+}
+
+// AddDependency makes a task list depend on another one, ignoring dependencies it already has.
+func (t *TaskListMongo) AddDependency(userId string, listId int, dependsOnId int) error {
+	return t.updateDependencies(userId, listId, bson.M{"$addToSet": bson.M{"depends_on": dependsOnId}})
+}
+
+// RemoveDependency removes a dependency from a task list.
+func (t *TaskListMongo) RemoveDependency(userId string, listId int, dependsOnId int) error {
+	return t.updateDependencies(userId, listId, bson.M{"$pull": bson.M{"depends_on": dependsOnId}})
+}
+
+// DetachDependency removes a task list from the dependencies of the other lists the user can access,
+// once it has been deleted.
+func (t *TaskListMongo) DetachDependency(userId string, listId int) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	filter, err := t.accessFilter(ctx, userId)
+	if err != nil {
+		return err
+	}
+	filter["depends_on"] = listId
+	if _, err := t.collection.UpdateMany(ctx, filter, bson.M{"$pull": bson.M{"depends_on": listId}}); err != nil {
+		return fmt.Errorf("error detaching task list dependency: %w", err)
+	}
+	return nil
+}
+
+// SetItems replaces the subtasks of a task list.
+func (t *TaskListMongo) SetItems(userId string, listId int, items []model.TaskItem) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	filter, err := t.accessFilter(ctx, userId)
+	if err != nil {
+		return err
+	}
+	filter["id"] = listId
+
+	update := bson.M{"$set": bson.M{"items": items}}
+	if len(items) == 0 {
+		update = bson.M{"$unset": bson.M{"items": ""}}
+	}
+	res, err := t.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return fmt.Errorf("error updating task list items: %w", err)
+	}
+	if res.MatchedCount == 0 {
+		return fmt.Errorf("task list with ID %d not found for user %s", listId, userId)
+	}
+	return nil
+}
+
+// updateDependencies applies an update to the dependencies of a task list the user can access.
+func (t *TaskListMongo) updateDependencies(userId string, listId int, update bson.M) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	filter, err := t.accessFilter(ctx, userId)
+	if err != nil {
+		return err
+	}
+	filter["id"] = listId
+
+	res, err := t.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return fmt.Errorf("error updating task list dependencies: %w", err)
+	}
+	if res.MatchedCount == 0 {
+		return fmt.Errorf("task list with ID %d not found for user %s", listId, userId)
+	}
+	return nil
+}
+
+// accessFilter matches the task lists the user owns or can reach through workspace membership.
+func (t *TaskListMongo) accessFilter(ctx context.Context, userId string) (bson.M, error) {
+	return workspaceAccessFilter(ctx, t.workspaces, userId)
+}
+
+// GetStats aggregates the statistics of the task lists the user can access in a single pipeline.
+// Only the weeks starting at since in which lists were created or completed are returned.
+func (t *TaskListMongo) GetStats(userId string, since, now time.Time) (model.Stats, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	filter, err := t.accessFilter(ctx, userId)
+	if err != nil {
+		return model.Stats{}, err
+	}
+
+	countBy := func(field interface{}) bson.M {
+		return bson.M{"_id": field, "count": bson.M{"$sum": 1}}
+	}
+	countByWeek := func(field string) bson.M {
+		return bson.M{
+			"_id":   bson.M{"year": bson.M{"$isoWeekYear": field}, "week": bson.M{"$isoWeek": field}},
+			"count": bson.M{"$sum": 1},
+		}
+	}
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: filter}},
+		{{Key: "$facet", Value: bson.M{
+			"by_status": bson.A{
+				bson.M{"$group": countBy("$status")},
+			},
+			"by_priority": bson.A{
+				bson.M{"$group": countBy(bson.M{"$ifNull": bson.A{"$priority", model.PriorityMedium}})},
+			},
+			"overdue": bson.A{
+				bson.M{"$match": bson.M{"status": bson.M{"$ne": model.StatusDone}, "due_date": bson.M{"$lt": now}}},
+				bson.M{"$sort": bson.D{{Key: "due_date", Value: 1}, {Key: "id", Value: 1}}},
+			},
+			"created": bson.A{
+				bson.M{"$match": bson.M{"created_at": bson.M{"$gte": since}}},
+				bson.M{"$group": countByWeek("$created_at")},
+			},
+			"completed": bson.A{
+				bson.M{"$match": bson.M{"status": model.StatusDone, "completed_at": bson.M{"$gte": since}}},
+				bson.M{"$group": countByWeek("$completed_at")},
+			},
+			"completion": bson.A{
+				bson.M{"$match": bson.M{
+					"status":       model.StatusDone,
+					"created_at":   bson.M{"$type": "date"},
+					"completed_at": bson.M{"$type": "date"},
+				}},
+				bson.M{"$group": bson.M{
+					"_id":     nil,
+					"average": bson.M{"$avg": bson.M{"$subtract": bson.A{"$completed_at", "$created_at"}}},
+				}},
+			},
+		}}},
+	}
+	cursor, err := t.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return model.Stats{}, fmt.Errorf("error aggregating task list stats: %w", err)
+	}
+
+	type count struct {
+		Key   string `bson:"_id"`
+		Count int    `bson:"count"`
+	}
+	type weekCount struct {
+		Key struct {
+			Year int `bson:"year"`
+			Week int `bson:"week"`
+		} `bson:"_id"`
+		Count int `bson:"count"`
+	}
+	var results []struct {
+		ByStatus   []count          `bson:"by_status"`
+		ByPriority []count          `bson:"by_priority"`
+		Overdue    []model.TaskList `bson:"overdue"`
+		Created    []weekCount      `bson:"created"`
+		Completed  []weekCount      `bson:"completed"`
+		Completion []struct {
+			// Average is in milliseconds, the unit of date subtraction.
+			Average float64 `bson:"average"`
+		} `bson:"completion"`
+	}
+	if err := cursor.All(ctx, &results); err != nil {
+		return model.Stats{}, fmt.Errorf("error decoding task list stats: %w", err)
+	}
+
+	stats := model.Stats{ByStatus: map[string]int{}, ByPriority: map[string]int{}, Overdue: []model.TaskList{}}
+	if len(results) == 0 {
+		return stats, nil
+	}
+	result := results[0]
+	for _, c := range result.ByStatus {
+		stats.ByStatus[c.Key] = c.Count
+	}
+	for _, c := range result.ByPriority {
+		stats.ByPriority[c.Key] = c.Count
+	}
+	if result.Overdue != nil {
+		stats.Overdue = result.Overdue
+	}
+
+	weeks := map[string]*model.WeeklyStats{}
+	week := func(c weekCount) *model.WeeklyStats {
+		label := fmt.Sprintf("%04d-W%02d", c.Key.Year, c.Key.Week)
+		if weeks[label] == nil {
+			weeks[label] = &model.WeeklyStats{Week: label}
+		}
+		return weeks[label]
+	}
+	for _, c := range result.Created {
+		week(c).Created = c.Count
+	}
+	for _, c := range result.Completed {
+		week(c).Completed = c.Count
+	}
+	for _, w := range weeks {
+		stats.Weekly = append(stats.Weekly, *w)
+	}
+
+	if len(result.Completion) > 0 {
+		seconds := int64(result.Completion[0].Average / 1000)
+		stats.AverageCompletionSeconds = &seconds
+	}
+	return stats, nil
+}
+
+// Reassign gives the task lists of one user to another and returns how many were moved.
+// When listIds is empty every list of the user is moved.
+func (t *TaskListMongo) Reassign(fromUserId, toUserId string, listIds []int) (int64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	filter := bson.M{"user_id": fromUserId}
+	if len(listIds) > 0 {
+		filter["id"] = bson.M{"$in": listIds}
+	}
+	result, err := t.collection.UpdateMany(ctx, filter, bson.M{"$set": bson.M{"user_id": toUserId}})
+	if err != nil {
+		return 0, fmt.Errorf("error reassigning task lists: %w", err)
+	}
+	return result.ModifiedCount, nil
+}
+
+// ReseedCounter sets the task list ID counter used by Create to the highest stored ID and returns it,
+// so that new lists do not collide with existing ones after a restore or a manual import.
+func (t *TaskListMongo) ReseedCounter() (int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var last struct{ Id int }
+	opts := options.FindOne().SetSort(bson.M{"id": -1}).SetProjection(bson.M{"id": 1})
+	err := t.collection.FindOne(ctx, bson.M{}, opts).Decode(&last)
+	if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		return 0, fmt.Errorf("error finding the highest task list id: %w", err)
+	}
+
+	counterColl := t.collection.Database().Collection("counters")
+	_, err = counterColl.UpdateOne(ctx, bson.M{"_id": taskListCounterId}, bson.M{"$set": bson.M{"seq": last.Id}}, options.UpdateOne().SetUpsert(true))
+	if err != nil {
+		return 0, fmt.Errorf("error reseeding task list counter: %w", err)
+	}
+	return last.Id, nil
+}
@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"TaskManager/internal/domain/model"
+	"context"
+	"errors"
+	"fmt"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"time"
+)
+
+// TemplateMongo stores task list templates in MongoDB.
+type TemplateMongo struct {
+	collection *mongo.Collection
+}
+
+// NewTemplateMongo initializes a new TemplateMongo instance with the provided MongoDB client and database name.
+func NewTemplateMongo(client *mongo.Client, dbName string) *TemplateMongo {
+	return &TemplateMongo{
+		collection: client.Database(dbName).Collection("templates"),
+	}
+}
+
+// Create inserts a new template and returns its ID.
+func (t *TemplateMongo) Create(template model.Template) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	template.Id = bson.NewObjectID()
+	if _, err := t.collection.InsertOne(ctx, template); err != nil {
+		return "", fmt.Errorf("error inserting template: %w", err)
+	}
+	return template.Id.Hex(), nil
+}
+
+// GetAll retrieves the user's templates ordered by name.
+func (t *TemplateMongo) GetAll(userId string) ([]model.Template, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	cursor, err := t.collection.Find(ctx, bson.M{"user_id": userId}, options.Find().SetSort(bson.M{"name": 1}))
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving templates: %w", err)
+	}
+
+	templates := []model.Template{}
+	if err := cursor.All(ctx, &templates); err != nil {
+		return nil, fmt.Errorf("error decoding templates: %w", err)
+	}
+	return templates, nil
+}
+
+// GetById retrieves a template of the user.
+func (t *TemplateMongo) GetById(userId string, id string) (model.Template, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	objectId, err := bson.ObjectIDFromHex(id)
+	if err != nil {
+		return model.Template{}, fmt.Errorf("invalid template id: %w", err)
+	}
+
+	var template model.Template
+	err = t.collection.FindOne(ctx, bson.M{"_id": objectId, "user_id": userId}).Decode(&template)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return model.Template{}, fmt.Errorf("template %s not found for user %s", id, userId)
+		}
+		return model.Template{}, fmt.Errorf("error retrieving template: %w", err)
+	}
+	return template, nil
+}
+
+// Update replaces the content of a template of the user.
+func (t *TemplateMongo) Update(userId string, id string, template model.Template) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	objectId, err := bson.ObjectIDFromHex(id)
+	if err != nil {
+		return fmt.Errorf("invalid template id: %w", err)
+	}
+
+	update := bson.M{"$set": bson.M{
+		"name":        template.Name,
+		"title":       template.Title,
+		"description": template.Description,
+		"tags":        template.Tags,
+		"items":       template.Items,
+		"updated_at":  template.UpdatedAt,
+	}}
+	res, err := t.collection.UpdateOne(ctx, bson.M{"_id": objectId, "user_id": userId}, update)
+	if err != nil {
+		return fmt.Errorf("error updating template: %w", err)
+	}
+	if res.MatchedCount == 0 {
+		return fmt.Errorf("template %s not found for user %s", id, userId)
+	}
+	return nil
+}
+
+// Delete removes a template of the user.
+func (t *TemplateMongo) Delete(userId string, id string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	objectId, err := bson.ObjectIDFromHex(id)
+	if err != nil {
+		return fmt.Errorf("invalid template id: %w", err)
+	}
+
+	res, err := t.collection.DeleteOne(ctx, bson.M{"_id": objectId, "user_id": userId})
+	if err != nil {
+		return fmt.Errorf("error deleting template: %w", err)
+	}
+	if res.DeletedCount == 0 {
+		return fmt.Errorf("template %s not found for user %s", id, userId)
+	}
+	return nil
+}
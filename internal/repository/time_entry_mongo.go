@@ -0,0 +1,153 @@
+package repository
+
+import (
+	"TaskManager/internal/domain/model"
+	"context"
+	"errors"
+	"fmt"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"time"
+)
+
+// TimeEntryMongo stores tracked time in MongoDB.
+type TimeEntryMongo struct {
+	collection *mongo.Collection
+}
+
+// NewTimeEntryMongo initializes a new TimeEntryMongo instance with the provided MongoDB client and database name.
+func NewTimeEntryMongo(client *mongo.Client, dbName string) *TimeEntryMongo {
+	return &TimeEntryMongo{
+		collection: client.Database(dbName).Collection("time_entries"),
+	}
+}
+
+// Create inserts a time entry and returns its ID.
+// Inserting a second running timer for a user fails on the unique index created by EnsureIndexes.
+func (t *TimeEntryMongo) Create(entry model.TimeEntry) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	entry.Id = bson.NewObjectID()
+	if _, err := t.collection.InsertOne(ctx, entry); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return "", fmt.Errorf("a timer is already running for user %s", entry.UserId)
+		}
+		return "", fmt.Errorf("error inserting time entry: %w", err)
+	}
+	return entry.Id.Hex(), nil
+}
+
+// GetRunning retrieves the user's running timer.
+func (t *TimeEntryMongo) GetRunning(userId string) (model.TimeEntry, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var entry model.TimeEntry
+	err := t.collection.FindOne(ctx, bson.M{"user_id": userId, "running": true}).Decode(&entry)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return model.TimeEntry{}, fmt.Errorf("no timer is running for user %s", userId)
+		}
+		return model.TimeEntry{}, fmt.Errorf("error retrieving running timer: %w", err)
+	}
+	return entry, nil
+}
+
+// Stop ends a running timer at the given time.
+func (t *TimeEntryMongo) Stop(id string, end time.Time, seconds int64) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	objectId, err := bson.ObjectIDFromHex(id)
+	if err != nil {
+		return fmt.Errorf("invalid time entry id: %w", err)
+	}
+
+	update := bson.M{"$set": bson.M{"end": end, "seconds": seconds, "running": false}}
+	res, err := t.collection.UpdateOne(ctx, bson.M{"_id": objectId, "running": true}, update)
+	if err != nil {
+		return fmt.Errorf("error stopping timer: %w", err)
+	}
+	if res.MatchedCount == 0 {
+		return fmt.Errorf("timer %s is not running", id)
+	}
+	return nil
+}
+
+// GetByList retrieves the time entries of a task list, newest first.
+func (t *TimeEntryMongo) GetByList(listId int) ([]model.TimeEntry, error) {
+	return t.find(bson.M{"list_id": listId}, -1)
+}
+
+// GetInRange retrieves the user's finished time entries that started within [from, to), oldest first.
+func (t *TimeEntryMongo) GetInRange(userId string, from, to time.Time) ([]model.TimeEntry, error) {
+	filter := bson.M{
+		"user_id": userId,
+		"running": false,
+		"start":   bson.M{"$gte": from, "$lt": to},
+	}
+	return t.find(filter, 1)
+}
+
+// Delete removes a time entry of the user from a task list.
+func (t *TimeEntryMongo) Delete(userId string, listId int, id string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	objectId, err := bson.ObjectIDFromHex(id)
+	if err != nil {
+		return fmt.Errorf("invalid time entry id: %w", err)
+	}
+
+	res, err := t.collection.DeleteOne(ctx, bson.M{"_id": objectId, "user_id": userId, "list_id": listId})
+	if err != nil {
+		return fmt.Errorf("error deleting time entry: %w", err)
+	}
+	if res.DeletedCount == 0 {
+		return fmt.Errorf("time entry %s not found for user %s", id, userId)
+	}
+	return nil
+}
+
+// EnsureIndexes creates the indexes used by time reports, and the partial unique index
+// that allows at most one running timer per user.
+func (t *TimeEntryMongo) EnsureIndexes() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	_, err := t.collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "start", Value: 1}}},
+		{Keys: bson.D{{Key: "list_id", Value: 1}, {Key: "start", Value: -1}}},
+		{
+			Keys: bson.D{{Key: "user_id", Value: 1}},
+			Options: options.Index().
+				SetName("one_running_timer_per_user").
+				SetUnique(true).
+				SetPartialFilterExpression(bson.M{"running": true}),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error creating time entry indexes: %w", err)
+	}
+	return nil
+}
+
+// find retrieves the time entries matching the filter sorted by start in the given direction.
+func (t *TimeEntryMongo) find(filter bson.M, direction int) ([]model.TimeEntry, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	opts := options.Find().SetSort(bson.D{{Key: "start", Value: direction}})
+	cursor, err := t.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving time entries: %w", err)
+	}
+
+	entries := []model.TimeEntry{}
+	if err := cursor.All(ctx, &entries); err != nil {
+		return nil, fmt.Errorf("error decoding time entries: %w", err)
+	}
+	return entries, nil
+}
@@ -0,0 +1,175 @@
+package repository
+
+import (
+	"TaskManager/internal/domain/model"
+	"context"
+	"errors"
+	"fmt"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"time"
+)
+
+const webhookDeliveryLogLimit = 100
+
+// WebhookMongo stores webhook subscriptions and their delivery log in MongoDB.
+type WebhookMongo struct {
+	collection *mongo.Collection
+	deliveries *mongo.Collection
+}
+
+// NewWebhookMongo initializes a new WebhookMongo instance with the provided MongoDB client and database name.
+func NewWebhookMongo(client *mongo.Client, dbName string) *WebhookMongo {
+	db := client.Database(dbName)
+	return &WebhookMongo{
+		collection: db.Collection("webhooks"),
+		deliveries: db.Collection("webhook_deliveries"),
+	}
+}
+
+// Create inserts a new webhook subscription for the user and returns its ID.
+func (w *WebhookMongo) Create(userId string, hook model.Webhook) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	hook.Id = bson.NewObjectID()
+	hook.UserId = userId
+	if _, err := w.collection.InsertOne(ctx, hook); err != nil {
+		return "", fmt.Errorf("error inserting webhook: %w", err)
+	}
+	return hook.Id.Hex(), nil
+}
+
+// GetAll retrieves all webhook subscriptions of the user.
+func (w *WebhookMongo) GetAll(userId string) ([]model.Webhook, error) {
+	return w.find(bson.M{"user_id": userId})
+}
+
+// GetByEvent retrieves the user's webhook subscriptions that listen to the event type.
+func (w *WebhookMongo) GetByEvent(userId string, event string) ([]model.Webhook, error) {
+	return w.find(bson.M{"user_id": userId, "events": event})
+}
+
+// GetById retrieves a specific webhook subscription of the user.
+func (w *WebhookMongo) GetById(userId string, id string) (model.Webhook, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	objectId, err := bson.ObjectIDFromHex(id)
+	if err != nil {
+		return model.Webhook{}, fmt.Errorf("invalid webhook id: %w", err)
+	}
+
+	var hook model.Webhook
+	err = w.collection.FindOne(ctx, bson.M{"_id": objectId, "user_id": userId}).Decode(&hook)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return model.Webhook{}, fmt.Errorf("webhook %s not found for user %s", id, userId)
+		}
+		return model.Webhook{}, fmt.Errorf("error retrieving webhook: %w", err)
+	}
+	return hook, nil
+}
+
+// Delete removes a webhook subscription of the user.
+func (w *WebhookMongo) Delete(userId string, id string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	objectId, err := bson.ObjectIDFromHex(id)
+	if err != nil {
+		return fmt.Errorf("invalid webhook id: %w", err)
+	}
+
+	res, err := w.collection.DeleteOne(ctx, bson.M{"_id": objectId, "user_id": userId})
+	if err != nil {
+		return fmt.Errorf("error deleting webhook: %w", err)
+	}
+	if res.DeletedCount == 0 {
+		return fmt.Errorf("webhook %s not found for user %s", id, userId)
+	}
+	return nil
+}
+
+// CreateDelivery inserts a new delivery into the log.
+func (w *WebhookMongo) CreateDelivery(delivery model.WebhookDelivery) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if delivery.Id.IsZero() {
+		delivery.Id = bson.NewObjectID()
+	}
+	if _, err := w.deliveries.InsertOne(ctx, delivery); err != nil {
+		return fmt.Errorf("error inserting webhook delivery: %w", err)
+	}
+	return nil
+}
+
+// UpdateDelivery replaces a delivery in the log after an attempt.
+func (w *WebhookMongo) UpdateDelivery(delivery model.WebhookDelivery) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if _, err := w.deliveries.ReplaceOne(ctx, bson.M{"_id": delivery.Id}, delivery); err != nil {
+		return fmt.Errorf("error updating webhook delivery: %w", err)
+	}
+	return nil
+}
+
+// GetPendingDeliveries retrieves the pending deliveries whose next attempt is due at or before the given time.
+func (w *WebhookMongo) GetPendingDeliveries(before time.Time) ([]model.WebhookDelivery, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	filter := bson.M{
+		"status":          model.WebhookDeliveryPending,
+		"next_attempt_at": bson.M{"$lte": before},
+	}
+	cursor, err := w.deliveries.Find(ctx, filter, options.Find().SetSort(bson.M{"next_attempt_at": 1}))
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving pending webhook deliveries: %w", err)
+	}
+
+	var deliveries []model.WebhookDelivery
+	if err := cursor.All(ctx, &deliveries); err != nil {
+		return nil, fmt.Errorf("error decoding webhook deliveries: %w", err)
+	}
+	return deliveries, nil
+}
+
+// GetDeliveries retrieves the most recent deliveries of a webhook, newest first.
+func (w *WebhookMongo) GetDeliveries(userId string, webhookId string) ([]model.WebhookDelivery, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	filter := bson.M{"user_id": userId, "webhook_id": webhookId}
+	opts := options.Find().SetSort(bson.M{"created_at": -1}).SetLimit(webhookDeliveryLogLimit)
+	cursor, err := w.deliveries.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving webhook deliveries: %w", err)
+	}
+
+	deliveries := []model.WebhookDelivery{}
+	if err := cursor.All(ctx, &deliveries); err != nil {
+		return nil, fmt.Errorf("error decoding webhook deliveries: %w", err)
+	}
+	return deliveries, nil
+}
+
+// find retrieves the webhooks matching the filter.
+func (w *WebhookMongo) find(filter bson.M) ([]model.Webhook, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	cursor, err := w.collection.Find(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving webhooks: %w", err)
+	}
+
+	hooks := []model.Webhook{}
+	if err := cursor.All(ctx, &hooks); err != nil {
+		return nil, fmt.Errorf("error decoding webhooks: %w", err)
+	}
+	return hooks, nil
+}
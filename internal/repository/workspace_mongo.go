@@ -0,0 +1,180 @@
+package repository
+
+import (
+	"TaskManager/internal/domain/model"
+	"context"
+	"errors"
+	"fmt"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"time"
+)
+
+// WorkspaceMongo stores workspaces and their members in MongoDB.
+type WorkspaceMongo struct {
+	collection *mongo.Collection
+}
+
+// NewWorkspaceMongo initializes a new WorkspaceMongo instance with the provided MongoDB client and database name.
+func NewWorkspaceMongo(client *mongo.Client, dbName string) *WorkspaceMongo {
+	return &WorkspaceMongo{
+		collection: client.Database(dbName).Collection("workspaces"),
+	}
+}
+
+// Create inserts a new workspace and returns its ID.
+func (w *WorkspaceMongo) Create(workspace model.Workspace) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	workspace.Id = bson.NewObjectID()
+	if _, err := w.collection.InsertOne(ctx, workspace); err != nil {
+		return "", fmt.Errorf("error inserting workspace: %w", err)
+	}
+	return workspace.Id.Hex(), nil
+}
+
+// GetAll retrieves the workspaces the user is a member of.
+func (w *WorkspaceMongo) GetAll(userId string) ([]model.Workspace, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	cursor, err := w.collection.Find(ctx, bson.M{"members.user_id": userId}, options.Find().SetSort(bson.M{"name": 1}))
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving workspaces: %w", err)
+	}
+
+	workspaces := []model.Workspace{}
+	if err := cursor.All(ctx, &workspaces); err != nil {
+		return nil, fmt.Errorf("error decoding workspaces: %w", err)
+	}
+	return workspaces, nil
+}
+
+// GetById retrieves a workspace the user is a member of.
+func (w *WorkspaceMongo) GetById(userId string, id string) (model.Workspace, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	objectId, err := bson.ObjectIDFromHex(id)
+	if err != nil {
+		return model.Workspace{}, fmt.Errorf("invalid workspace id: %w", err)
+	}
+
+	var workspace model.Workspace
+	err = w.collection.FindOne(ctx, bson.M{"_id": objectId, "members.user_id": userId}).Decode(&workspace)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return model.Workspace{}, fmt.Errorf("workspace %s not found for user %s", id, userId)
+		}
+		return model.Workspace{}, fmt.Errorf("error retrieving workspace: %w", err)
+	}
+	return workspace, nil
+}
+
+// Update renames a workspace.
+func (w *WorkspaceMongo) Update(id string, name string) error {
+	return w.updateOne(id, bson.M{}, bson.M{"$set": bson.M{"name": name}})
+}
+
+// Delete removes a workspace.
+func (w *WorkspaceMongo) Delete(id string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	objectId, err := bson.ObjectIDFromHex(id)
+	if err != nil {
+		return fmt.Errorf("invalid workspace id: %w", err)
+	}
+
+	res, err := w.collection.DeleteOne(ctx, bson.M{"_id": objectId})
+	if err != nil {
+		return fmt.Errorf("error deleting workspace: %w", err)
+	}
+	if res.DeletedCount == 0 {
+		return fmt.Errorf("workspace %s not found", id)
+	}
+	return nil
+}
+
+// AddMember adds a user to a workspace unless they are already a member.
+func (w *WorkspaceMongo) AddMember(id string, member model.WorkspaceMember) error {
+	filter := bson.M{"members.user_id": bson.M{"$ne": member.UserId}}
+	return w.updateOne(id, filter, bson.M{"$push": bson.M{"members": member}})
+}
+
+// UpdateMember changes the role of a member of a workspace.
+func (w *WorkspaceMongo) UpdateMember(id string, userId string, role string) error {
+	filter := bson.M{"members.user_id": userId}
+	return w.updateOne(id, filter, bson.M{"$set": bson.M{"members.$.role": role}})
+}
+
+// RemoveMember removes a user from a workspace.
+func (w *WorkspaceMongo) RemoveMember(id string, userId string) error {
+	filter := bson.M{"members.user_id": userId}
+	return w.updateOne(id, filter, bson.M{"$pull": bson.M{"members": bson.M{"user_id": userId}}})
+}
+
+// EnsureIndexes creates the index used to find a user's workspaces.
+func (w *WorkspaceMongo) EnsureIndexes() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	_, err := w.collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "members.user_id", Value: 1}},
+	})
+	if err != nil {
+		return fmt.Errorf("error creating workspace indexes: %w", err)
+	}
+	return nil
+}
+
+// updateOne applies the update to the workspace when it also matches the filter.
+func (w *WorkspaceMongo) updateOne(id string, filter bson.M, update bson.M) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	objectId, err := bson.ObjectIDFromHex(id)
+	if err != nil {
+		return fmt.Errorf("invalid workspace id: %w", err)
+	}
+	filter["_id"] = objectId
+
+	res, err := w.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return fmt.Errorf("error updating workspace: %w", err)
+	}
+	if res.MatchedCount == 0 {
+		return fmt.Errorf("workspace %s not found", id)
+	}
+	return nil
+}
+
+// workspaceAccessFilter matches documents the user created or that belong to a workspace the user is a member of.
+func workspaceAccessFilter(ctx context.Context, workspaces *mongo.Collection, userId string) (bson.M, error) {
+	opts := options.Find().SetProjection(bson.M{"_id": 1})
+	cursor, err := workspaces.Find(ctx, bson.M{"members.user_id": userId}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving workspaces: %w", err)
+	}
+
+	var memberships []struct {
+		Id bson.ObjectID `bson:"_id"`
+	}
+	if err := cursor.All(ctx, &memberships); err != nil {
+		return nil, fmt.Errorf("error decoding workspaces: %w", err)
+	}
+	if len(memberships) == 0 {
+		return bson.M{"user_id": userId}, nil
+	}
+
+	workspaceIds := make([]string, 0, len(memberships))
+	for _, membership := range memberships {
+		workspaceIds = append(workspaceIds, membership.Id.Hex())
+	}
+	return bson.M{"$or": bson.A{
+		bson.M{"user_id": userId},
+		bson.M{"workspace_id": bson.M{"$in": workspaceIds}},
+	}}, nil
+}
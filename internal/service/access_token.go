@@ -0,0 +1,145 @@
+package service
+
+import (
+	"TaskManager/internal/domain/model"
+	"TaskManager/internal/repository"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	accessTokenDefaultTTL = 90 * 24 * time.Hour
+	accessTokenMaxTTL     = 366 * 24 * time.Hour
+	maxAccessTokenName    = 100
+	// accessTokenUsageInterval limits how often the last use of a token is written.
+	accessTokenUsageInterval = time.Minute
+)
+
+// ErrInvalidAccessToken is returned for personal access tokens that are unknown, expired or revoked.
+var ErrInvalidAccessToken = errors.New("invalid access token")
+
+// AccessTokenService manages personal access tokens and authenticates requests made with them.
+type AccessTokenService struct {
+	repo  repository.AccessToken
+	users repository.Authorization
+	now   func() time.Time
+}
+
+// NewAccessTokenService initializes a new AccessTokenService with the provided repositories.
+func NewAccessTokenService(repo repository.AccessToken, users repository.Authorization) *AccessTokenService {
+	return &AccessTokenService{repo: repo, users: users, now: time.Now}
+}
+
+// Create issues a personal access token for the user. The returned token value cannot be retrieved again.
+// granted are the scopes of the token the request was made with; the new token cannot have any other scope.
+func (s *AccessTokenService) Create(userId string, granted []string, input model.AccessTokenInput) (model.CreatedAccessToken, error) {
+	now := s.now()
+	if err := validateAccessToken(input, now); err != nil {
+		return model.CreatedAccessToken{}, err
+	}
+	for _, scope := range input.Scopes {
+		if !slices.Contains(granted, scope) {
+			return model.CreatedAccessToken{}, fmt.Errorf("cannot grant scope %q, the requesting token does not have it", scope)
+		}
+	}
+
+	secret, err := randomToken()
+	if err != nil {
+		return model.CreatedAccessToken{}, err
+	}
+	value := model.AccessTokenPrefix + secret
+
+	token := model.AccessToken{
+		UserId:    userId,
+		Name:      strings.TrimSpace(input.Name),
+		Scopes:    input.Scopes,
+		Hash:      hashAccessToken(value),
+		ExpiresAt: now.Add(accessTokenDefaultTTL),
+		CreatedAt: now,
+	}
+	if input.ExpiresAt != nil {
+		token.ExpiresAt = *input.ExpiresAt
+	}
+
+	id, err := s.repo.Create(token)
+	if err != nil {
+		return model.CreatedAccessToken{}, err
+	}
+	if token.Id, err = bson.ObjectIDFromHex(id); err != nil {
+		return model.CreatedAccessToken{}, fmt.Errorf("invalid access token id: %w", err)
+	}
+	return model.CreatedAccessToken{AccessToken: token, Token: value}, nil
+}
+
+// GetAll retrieves the user's personal access tokens, including expired and revoked ones.
+func (s *AccessTokenService) GetAll(userId string) ([]model.AccessToken, error) {
+	return s.repo.GetAll(userId)
+}
+
+// Revoke revokes a personal access token of the user. It is rejected from then on.
+func (s *AccessTokenService) Revoke(userId string, id string) error {
+	return s.repo.Revoke(userId, id, s.now())
+}
+
+// Authenticate returns the personal access token with the given value if it can be used,
+// and records its use.
+func (s *AccessTokenService) Authenticate(value string) (model.AccessToken, error) {
+	token, err := s.repo.GetByHash(hashAccessToken(value))
+	if err != nil {
+		return model.AccessToken{}, ErrInvalidAccessToken
+	}
+	now := s.now()
+	if token.RevokedAt != nil || !now.Before(token.ExpiresAt) {
+		return model.AccessToken{}, ErrInvalidAccessToken
+	}
+	user, err := s.users.GetUserById(token.UserId)
+	if err != nil || user.Disabled {
+		return model.AccessToken{}, ErrInvalidAccessToken
+	}
+
+	if token.LastUsedAt == nil || now.Sub(*token.LastUsedAt) >= accessTokenUsageInterval {
+		if err := s.repo.SetLastUsed(token.Id.Hex(), now); err != nil {
+			return model.AccessToken{}, err
+		}
+		token.LastUsedAt = &now
+	}
+	return token, nil
+}
+
+// hashAccessToken returns the hex-encoded SHA-256 hash under which a token is stored.
+// Tokens are long random values, so a fast hash is enough to make a leaked database useless.
+func hashAccessToken(value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(sum[:])
+}
+
+// validateAccessToken checks if the personal access token input is valid.
+func validateAccessToken(input model.AccessTokenInput, now time.Time) error {
+	name := strings.TrimSpace(input.Name)
+	if name == "" || len(name) > maxAccessTokenName {
+		return fmt.Errorf("token name must be between 1 and %d characters", maxAccessTokenName)
+	}
+	if len(input.Scopes) == 0 {
+		return errors.New("at least one scope is required")
+	}
+	for _, scope := range input.Scopes {
+		if !slices.Contains(model.Scopes, scope) {
+			return fmt.Errorf("unknown scope %q", scope)
+		}
+	}
+	if input.ExpiresAt != nil {
+		if !input.ExpiresAt.After(now) {
+			return errors.New("expires_at must be in the future")
+		}
+		if input.ExpiresAt.Sub(now) > accessTokenMaxTTL {
+			return errors.New("tokens can be valid for at most a year")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,150 @@
+package service
+
+import (
+	"TaskManager/internal/domain/model"
+	"TaskManager/internal/repository"
+	"errors"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"strings"
+	"testing"
+	"time"
+)
+
+// fakeAccessTokens keeps personal access tokens in memory.
+type fakeAccessTokens struct {
+	repository.AccessToken
+	tokens   map[string]model.AccessToken
+	lastUsed int
+}
+
+func (f *fakeAccessTokens) Create(token model.AccessToken) (string, error) {
+	token.Id = bson.NewObjectID()
+	f.tokens[token.Hash] = token
+	return token.Id.Hex(), nil
+}
+
+func (f *fakeAccessTokens) GetByHash(hash string) (model.AccessToken, error) {
+	token, ok := f.tokens[hash]
+	if !ok {
+		return model.AccessToken{}, errors.New("access token not found")
+	}
+	return token, nil
+}
+
+func (f *fakeAccessTokens) SetLastUsed(id string, at time.Time) error {
+	f.lastUsed++
+	for hash, token := range f.tokens {
+		if token.Id.Hex() == id {
+			token.LastUsedAt = &at
+			f.tokens[hash] = token
+		}
+	}
+	return nil
+}
+
+type fakeTokenOwners struct {
+	repository.Authorization
+	disabled bool
+}
+
+func (f *fakeTokenOwners) GetUserById(id string) (model.User, error) {
+	return model.User{Username: "alice", Disabled: f.disabled}, nil
+}
+
+func TestValidateAccessToken(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	inMonth, past, inTwoYears := now.AddDate(0, 1, 0), now.Add(-time.Hour), now.AddDate(2, 0, 0)
+
+	testTable := []struct {
+		name    string
+		input   model.AccessTokenInput
+		wantErr bool
+	}{
+		{name: "Valid", input: model.AccessTokenInput{Name: "ci", Scopes: []string{model.ScopeTasksRead}, ExpiresAt: &inMonth}},
+		{name: "Default Expiry", input: model.AccessTokenInput{Name: "ci", Scopes: model.Scopes}},
+		{name: "Missing Name", input: model.AccessTokenInput{Name: "  ", Scopes: []string{model.ScopeTasksRead}}, wantErr: true},
+		{name: "Long Name", input: model.AccessTokenInput{Name: strings.Repeat("a", 101), Scopes: []string{model.ScopeTasksRead}}, wantErr: true},
+		{name: "No Scopes", input: model.AccessTokenInput{Name: "ci"}, wantErr: true},
+		{name: "Unknown Scope", input: model.AccessTokenInput{Name: "ci", Scopes: []string{"tasks:delete"}}, wantErr: true},
+		{name: "Expired", input: model.AccessTokenInput{Name: "ci", Scopes: []string{model.ScopeTasksRead}, ExpiresAt: &past}, wantErr: true},
+		{name: "Too Long", input: model.AccessTokenInput{Name: "ci", Scopes: []string{model.ScopeTasksRead}, ExpiresAt: &inTwoYears}, wantErr: true},
+	}
+
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateAccessToken(tt.input, now)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateAccessToken() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAccessTokenService_Authenticate(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	repo := &fakeAccessTokens{tokens: map[string]model.AccessToken{}}
+	owners := &fakeTokenOwners{}
+	s := NewAccessTokenService(repo, owners)
+	s.now = func() time.Time { return now }
+
+	created, err := s.Create("user-1", model.Scopes, model.AccessTokenInput{Name: "ci", Scopes: []string{model.ScopeTasksRead}})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if !strings.HasPrefix(created.Token, model.AccessTokenPrefix) || created.Hash == created.Token || !created.ExpiresAt.Equal(now.Add(accessTokenDefaultTTL)) {
+		t.Fatalf("Create() = %+v", created)
+	}
+	for hash := range repo.tokens {
+		if strings.Contains(hash, created.Token) {
+			t.Fatal("the token value is stored unhashed")
+		}
+	}
+
+	token, err := s.Authenticate(created.Token)
+	if err != nil || token.UserId != "user-1" {
+		t.Fatalf("Authenticate() = %+v, %v", token, err)
+	}
+	// Uses within a minute of the recorded one are not written again.
+	now = now.Add(30 * time.Second)
+	s.Authenticate(created.Token)
+	now = now.Add(time.Minute)
+	s.Authenticate(created.Token)
+	if repo.lastUsed != 2 {
+		t.Errorf("last use recorded %d times, want 2", repo.lastUsed)
+	}
+
+	if _, err := s.Authenticate(created.Token + "x"); !errors.Is(err, ErrInvalidAccessToken) {
+		t.Errorf("Authenticate() of an unknown token error = %v", err)
+	}
+
+	owners.disabled = true
+	if _, err := s.Authenticate(created.Token); !errors.Is(err, ErrInvalidAccessToken) {
+		t.Errorf("Authenticate() for a disabled user error = %v", err)
+	}
+	owners.disabled = false
+
+	now = created.ExpiresAt
+	if _, err := s.Authenticate(created.Token); !errors.Is(err, ErrInvalidAccessToken) {
+		t.Errorf("Authenticate() of an expired token error = %v", err)
+	}
+
+	now = created.CreatedAt
+	stored := repo.tokens[created.Hash]
+	stored.RevokedAt = &now
+	repo.tokens[created.Hash] = stored
+	if _, err := s.Authenticate(created.Token); !errors.Is(err, ErrInvalidAccessToken) {
+		t.Errorf("Authenticate() of a revoked token error = %v", err)
+	}
+}
+
+func TestAccessTokenService_CreateLimitsScopesToCaller(t *testing.T) {
+	s := NewAccessTokenService(&fakeAccessTokens{tokens: map[string]model.AccessToken{}}, &fakeTokenOwners{})
+	granted := []string{model.ScopeTasksRead, model.ScopeAccountAdmin}
+
+	if _, err := s.Create("user-1", granted, model.AccessTokenInput{Name: "ci", Scopes: []string{model.ScopeTasksRead}}); err != nil {
+		t.Errorf("Create() with a granted scope error = %v", err)
+	}
+	if _, err := s.Create("user-1", granted, model.AccessTokenInput{Name: "ci", Scopes: []string{model.ScopeTasksRead, model.ScopeTasksWrite}}); err == nil {
+		t.Error("Create() with a scope the caller lacks expected an error")
+	}
+}
@@ -0,0 +1,192 @@
+package service
+
+import (
+	"TaskManager/internal/blobstore"
+	"TaskManager/internal/domain/model"
+	"TaskManager/internal/repository"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"io"
+	"mime"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+)
+
+const (
+	// sniffLength is the number of leading bytes inspected to detect the content type of an upload.
+	sniffLength           = 512
+	maxAttachmentFilename = 255
+)
+
+// AttachmentLimits restricts the size and content types of uploaded files.
+// An entry of AllowedTypes such as "image/*" allows every subtype.
+type AttachmentLimits struct {
+	MaxSize      int64
+	AllowedTypes []string
+}
+
+// AttachmentService manages files attached to task lists. Their content is kept in a blob store.
+type AttachmentService struct {
+	repo       repository.Attachment
+	lists      repository.TaskList
+	workspaces repository.Workspace
+	blobs      blobstore.BlobStore
+	limits     AttachmentLimits
+}
+
+// NewAttachmentService initializes a new AttachmentService with the provided repositories, blob store and limits.
+func NewAttachmentService(repo repository.Attachment, lists repository.TaskList, workspaces repository.Workspace, blobs blobstore.BlobStore, limits AttachmentLimits) *AttachmentService {
+	return &AttachmentService{
+		repo:       repo,
+		lists:      lists,
+		workspaces: workspaces,
+		blobs:      blobs,
+		limits:     limits,
+	}
+}
+
+// MaxAttachmentSize returns the largest accepted upload in bytes.
+func (s *AttachmentService) MaxAttachmentSize() int64 {
+	return s.limits.MaxSize
+}
+
+// CreateAttachment stores an uploaded file on a task list the user can access.
+// The content type is detected from the content instead of trusting the client.
+func (s *AttachmentService) CreateAttachment(userId string, listId int, filename string, size int64, content io.Reader) (model.Attachment, error) {
+	filename, err := validateAttachmentFilename(filename)
+	if err != nil {
+		return model.Attachment{}, err
+	}
+	if size > s.limits.MaxSize {
+		return model.Attachment{}, fmt.Errorf("attachment cannot be larger than %d bytes", s.limits.MaxSize)
+	}
+	list, err := s.lists.GetById(userId, listId)
+	if err != nil {
+		return model.Attachment{}, err
+	}
+	if err := authorizeListWrite(s.workspaces, userId, list); err != nil {
+		return model.Attachment{}, err
+	}
+
+	head := make([]byte, sniffLength)
+	n, err := io.ReadFull(content, head)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return model.Attachment{}, fmt.Errorf("error reading attachment: %w", err)
+	}
+	head = head[:n]
+	contentType := http.DetectContentType(head)
+	if !allowedContentType(contentType, s.limits.AllowedTypes) {
+		return model.Attachment{}, fmt.Errorf("attachments of type %s are not allowed", contentType)
+	}
+
+	attachment := model.Attachment{
+		Id:          bson.NewObjectID(),
+		ListId:      listId,
+		UserId:      userId,
+		Filename:    filename,
+		ContentType: contentType,
+		Size:        size,
+		CreatedAt:   time.Now(),
+	}
+	attachment.Key = fmt.Sprintf("task-lists/%d/%s", listId, attachment.Id.Hex())
+
+	ctx := context.Background()
+	if err := s.blobs.Put(ctx, attachment.Key, io.MultiReader(bytes.NewReader(head), content), size, contentType); err != nil {
+		return model.Attachment{}, err
+	}
+	if _, err := s.repo.Create(attachment); err != nil {
+		s.blobs.Delete(ctx, attachment.Key)
+		return model.Attachment{}, err
+	}
+	return attachment, nil
+}
+
+// GetAttachments retrieves the attachments of a task list the user can access.
+func (s *AttachmentService) GetAttachments(userId string, listId int) ([]model.Attachment, error) {
+	if _, err := s.lists.GetById(userId, listId); err != nil {
+		return nil, err
+	}
+	return s.repo.GetAll(listId)
+}
+
+// OpenAttachment returns an attachment with a reader streaming its content. The caller closes the reader.
+func (s *AttachmentService) OpenAttachment(userId string, listId int, id string) (model.Attachment, io.ReadCloser, error) {
+	if _, err := s.lists.GetById(userId, listId); err != nil {
+		return model.Attachment{}, nil, err
+	}
+	attachment, err := s.repo.GetById(listId, id)
+	if err != nil {
+		return model.Attachment{}, nil, err
+	}
+	content, err := s.blobs.Get(context.Background(), attachment.Key)
+	if err != nil {
+		return model.Attachment{}, nil, err
+	}
+	return attachment, content, nil
+}
+
+// DeleteAttachment removes an attachment from a task list the user can access.
+// The record goes first, so a failure to remove the file never leaves a broken attachment behind.
+func (s *AttachmentService) DeleteAttachment(userId string, listId int, id string) error {
+	list, err := s.lists.GetById(userId, listId)
+	if err != nil {
+		return err
+	}
+	if err := authorizeListWrite(s.workspaces, userId, list); err != nil {
+		return err
+	}
+	attachment, err := s.repo.GetById(listId, id)
+	if err != nil {
+		return err
+	}
+	if err := s.repo.Delete(id); err != nil {
+		return err
+	}
+	return s.blobs.Delete(context.Background(), attachment.Key)
+}
+
+// DeleteFiles removes the content of attachments whose records are already gone, such as those of a deleted task list.
+func (s *AttachmentService) DeleteFiles(attachments []model.Attachment) error {
+	var errs []error
+	for _, attachment := range attachments {
+		if err := s.blobs.Delete(context.Background(), attachment.Key); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// allowedContentType reports whether the media type of contentType matches one of the allowed types.
+func allowedContentType(contentType string, allowed []string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	for _, pattern := range allowed {
+		pattern = strings.ToLower(strings.TrimSpace(pattern))
+		if pattern == mediaType {
+			return true
+		}
+		if prefix, ok := strings.CutSuffix(pattern, "/*"); ok && strings.HasPrefix(mediaType, prefix+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// validateAttachmentFilename strips any directories from the uploaded name and checks what is left.
+func validateAttachmentFilename(filename string) (string, error) {
+	filename = strings.TrimSpace(path.Base(strings.ReplaceAll(filename, "\\", "/")))
+	if filename == "" || filename == "." || filename == "/" {
+		return "", errors.New("attachment filename cannot be empty")
+	}
+	if len(filename) > maxAttachmentFilename {
+		return "", fmt.Errorf("attachment filename cannot be longer than %d characters", maxAttachmentFilename)
+	}
+	return filename, nil
+}
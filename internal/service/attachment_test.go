@@ -0,0 +1,78 @@
+package service
+
+import (
+	"TaskManager/internal/domain/model"
+	"strings"
+	"testing"
+)
+
+func TestAllowedContentType(t *testing.T) {
+	allowed := []string{"image/*", "application/pdf", "Text/Plain"}
+
+	testTable := []struct {
+		name        string
+		contentType string
+		want        bool
+	}{
+		{name: "Wildcard Subtype", contentType: "image/png", want: true},
+		{name: "Exact", contentType: "application/pdf", want: true},
+		{name: "Parameters Ignored", contentType: "text/plain; charset=utf-8", want: true},
+		{name: "Not Allowed", contentType: "application/zip", want: false},
+		{name: "Wildcard Is Not A Prefix Match", contentType: "imagex/png", want: false},
+		{name: "Malformed", contentType: "not a type", want: false},
+	}
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := allowedContentType(tt.contentType, allowed); got != tt.want {
+				t.Errorf("allowedContentType(%q) = %v, want %v", tt.contentType, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateAttachmentFilename(t *testing.T) {
+	testTable := []struct {
+		name     string
+		filename string
+		want     string
+		wantErr  bool
+	}{
+		{name: "Plain", filename: "screenshot.png", want: "screenshot.png"},
+		{name: "Unix Path", filename: "/home/user/report.pdf", want: "report.pdf"},
+		{name: "Windows Path", filename: `C:\Users\me\notes.txt`, want: "notes.txt"},
+		{name: "Traversal", filename: "../../etc/passwd", want: "passwd"},
+		{name: "Empty", filename: "  ", wantErr: true},
+		{name: "Only Slash", filename: "/", wantErr: true},
+		{name: "Too Long", filename: strings.Repeat("a", maxAttachmentFilename+1), wantErr: true},
+	}
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := validateAttachmentFilename(tt.filename)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("validateAttachmentFilename(%q) error = %v, wantErr %v", tt.filename, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("validateAttachmentFilename(%q) = %q, want %q", tt.filename, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAttachmentChanges_CreatorWhoLeftTheWorkspace(t *testing.T) {
+	// carol created the list in a workspace she has since left, so she can still read it but not change it.
+	lists := &fakeDependencyLists{
+		lists:   map[int]model.TaskList{1: {Id: 1, UserId: "carol", WorkspaceId: "ws"}},
+		visible: map[string][]int{"carol": {1}},
+	}
+	workspaces := &fakeWorkspaces{workspaces: map[string]model.Workspace{
+		"ws": {Members: []model.WorkspaceMember{{UserId: "alice", Role: model.RoleOwner}}},
+	}}
+	s := NewAttachmentService(nil, lists, workspaces, nil, AttachmentLimits{MaxSize: 1024})
+
+	if _, err := s.CreateAttachment("carol", 1, "notes.txt", 5, strings.NewReader("notes")); err == nil {
+		t.Error("CreateAttachment() expected an error for a former member")
+	}
+	if err := s.DeleteAttachment("carol", 1, "attachment"); err == nil {
+		t.Error("DeleteAttachment() expected an error for a former member")
+	}
+}
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"github.com/golang-jwt/jwt/v5"
 	"golang.org/x/crypto/bcrypt"
-	"os"
+	"net/mail"
 	"regexp"
 	"time"
 )
@@ -14,20 +14,26 @@ import (
 // AuthService defines the interface for user authentication and authorization operations.
 type AuthService struct {
 	repo repository.Authorization
+	keys *TokenKeys
 }
 
 const (
+	// tokenTTL is the lifetime of access tokens unless TokenSettings sets another.
 	tokenTTL = 12 * time.Hour
 )
 
 type tokenClaims struct {
 	jwt.RegisteredClaims
-	UserId string `json:"user_id" bson:"user_id"`
+	UserId string   `json:"user_id" bson:"user_id"`
+	Scopes []string `json:"scopes,omitempty" bson:"scopes,omitempty"`
+	// Purpose is set on tokens that are not access tokens, such as login challenges.
+	Purpose string `json:"purpose,omitempty" bson:"purpose,omitempty"`
 }
 
-// NewAuthService initializes a new AuthService instance with the provided repository.
-func NewAuthService(repo repository.Authorization) *AuthService {
-	return &AuthService{repo: repo}
+// NewAuthService initializes a new AuthService instance with the provided repository and token keys.
+// The keys may be nil when the service is only used to manage users.
+func NewAuthService(repo repository.Authorization, keys *TokenKeys) *AuthService {
+	return &AuthService{repo: repo, keys: keys}
 }
 
 // CreateUser creates a new user in the system.
@@ -40,41 +46,80 @@ func (s *AuthService) CreateUser(user model.User) (int, error) {
 }
 
 // GenerateToken generates a JWT token for the user based on their username and password.
+// Users with two-factor authentication get a TwoFactorChallenge error instead.
 func (s *AuthService) GenerateToken(username, password string) (string, error) {
 	user, err := s.repo.GetUser(username, password)
 	if err != nil {
 		return "", fmt.Errorf("failed to get user: %w", err)
 	}
+	if user.Disabled {
+		return "", fmt.Errorf("account is disabled")
+	}
+
+	return loginToken(s.keys, user)
+}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, &tokenClaims{
+// issueToken returns an access token for the user.
+func issueToken(keys *TokenKeys, user model.User) (string, error) {
+	return keys.Sign(&tokenClaims{
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(tokenTTL)),
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(keys.ttl)),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 		},
 		UserId: user.Id.Hex(),
+		Scopes: model.Scopes,
 	})
-
-	return token.SignedString([]byte(os.Getenv("SIGNING_KEY")))
 }
 
 // ParseToken parses a JWT token and returns the user ID associated with it.
 func (s *AuthService) ParseToken(tokenString string) (string, error) {
-	parsedToken, err := jwt.ParseWithClaims(tokenString, &tokenClaims{}, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-		}
-		return []byte(os.Getenv("SIGNING_KEY")), nil
-	})
+	userId, _, err := s.ParseTokenScopes(tokenString)
+	return userId, err
+}
+
+// ParseTokenScopes parses a JWT token and returns the user ID and the scopes it grants.
+// Tokens issued before scopes were introduced carry none and are granted every scope.
+// Tokens of disabled accounts are rejected, even when they were issued before the account was disabled.
+func (s *AuthService) ParseTokenScopes(tokenString string) (string, []string, error) {
+	parsedToken, err := s.keys.Parse(tokenString, &tokenClaims{})
 	if err != nil {
-		return "", err
+		return "", nil, err
 	}
 
 	claims, ok := parsedToken.Claims.(*tokenClaims)
-	if !ok || !parsedToken.Valid {
-		return "", fmt.Errorf("invalid token")
+	if !ok || !parsedToken.Valid || claims.Purpose != "" {
+		return "", nil, fmt.Errorf("invalid token")
+	}
+
+	user, err := s.repo.GetUserById(claims.UserId)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	if user.Disabled {
+		return "", nil, fmt.Errorf("account is disabled")
+	}
+
+	if len(claims.Scopes) == 0 {
+		return claims.UserId, model.Scopes, nil
 	}
+	return claims.UserId, claims.Scopes, nil
+}
 
-	return claims.UserId, nil
+// PublicKeys returns the keys other services use to verify access tokens.
+func (s *AuthService) PublicKeys() model.JSONWebKeySet {
+	return s.keys.PublicKeys()
+}
+
+// GetUsers retrieves the users with the given IDs, without their password hashes.
+func (s *AuthService) GetUsers(ids []string) ([]model.User, error) {
+	users, err := s.repo.GetUsersByIds(ids)
+	if err != nil {
+		return nil, err
+	}
+	for i := range users {
+		users[i].Password = ""
+	}
+	return users, nil
 }
 
 // validateUser checks if the user data meets the required validation criteria.
@@ -87,11 +132,26 @@ func validateUser(user model.User) error {
 		return fmt.Errorf("username can contain only English letters and digits")
 	}
 
-	if len(user.Password) < 6 {
+	if err := validatePassword(user.Password); err != nil {
+		return err
+	}
+
+	if user.Email != "" {
+		if _, err := mail.ParseAddress(user.Email); err != nil {
+			return fmt.Errorf("email address is invalid")
+		}
+	}
+
+	return nil
+}
+
+// validatePassword checks if the password meets the required validation criteria.
+func validatePassword(password string) error {
+	if len(password) < 6 {
 		return fmt.Errorf("password must be at least 6 characters")
 	}
 
-	if !isValidPassword(user.Password) {
+	if !isValidPassword(password) {
 		return fmt.Errorf("password can contain only English letters,digits and symbols (_ , !)")
 	}
 
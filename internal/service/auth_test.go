@@ -2,8 +2,11 @@ package service
 
 import (
 	"TaskManager/internal/domain/model"
+	"TaskManager/internal/repository"
 	"golang.org/x/crypto/bcrypt"
+	"slices"
 	"testing"
+	"time"
 )
 
 func TestValidateUser_ValidInput(t *testing.T) {
@@ -256,3 +259,112 @@ func TestGeneratePasswordHash(t *testing.T) {
 		})
 	}
 }
+
+// fakeUsers serves a single user and records password changes.
+type fakeUsers struct {
+	repository.Authorization
+	user         model.User
+	passwordHash string
+}
+
+func (f *fakeUsers) GetUser(username, password string) (model.User, error) {
+	return f.user, nil
+}
+
+func (f *fakeUsers) GetUserById(id string) (model.User, error) {
+	return f.user, nil
+}
+
+func (f *fakeUsers) SetPassword(id string, passwordHash string) error {
+	f.passwordHash = passwordHash
+	return nil
+}
+
+func TestGenerateToken_DisabledUser(t *testing.T) {
+	s := NewAuthService(&fakeUsers{user: model.User{Username: "alice", Disabled: true}}, nil)
+	if _, err := s.GenerateToken("alice", "secret"); err == nil {
+		t.Error("GenerateToken() for a disabled user expected an error")
+	}
+}
+
+func TestResetPassword(t *testing.T) {
+	testTable := []struct {
+		name     string
+		password string
+		wantErr  bool
+	}{
+		{name: "Valid Password", password: "NewPassword_1"},
+		{name: "Too Short", password: "abc", wantErr: true},
+		{name: "Invalid Characters", password: "pass word", wantErr: true},
+	}
+
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			users := &fakeUsers{}
+			err := NewUserAdminService(users).ResetPassword("user-1", tt.password)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ResetPassword() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if users.passwordHash != "" {
+					t.Error("ResetPassword() stored a password that failed validation")
+				}
+				return
+			}
+			if err := bcrypt.CompareHashAndPassword([]byte(users.passwordHash), []byte(tt.password)); err != nil {
+				t.Errorf("stored hash does not match the new password: %v", err)
+			}
+		})
+	}
+}
+
+func TestParseTokenScopes(t *testing.T) {
+	keys, err := NewTokenKeys(TokenSettings{Secret: "secret", TTL: time.Hour})
+	if err != nil {
+		t.Fatalf("NewTokenKeys() error = %v", err)
+	}
+	s := NewAuthService(&fakeUsers{}, keys)
+
+	readOnly := testClaims()
+	readOnly.Scopes = []string{model.ScopeTasksRead}
+	testTable := []struct {
+		name       string
+		claims     *tokenClaims
+		wantScopes []string
+	}{
+		{name: "Scoped Token", claims: readOnly, wantScopes: []string{model.ScopeTasksRead}},
+		{name: "Token Without Scopes", claims: testClaims(), wantScopes: model.Scopes},
+	}
+
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			token, err := keys.Sign(tt.claims)
+			if err != nil {
+				t.Fatalf("Sign() error = %v", err)
+			}
+			userId, scopes, err := s.ParseTokenScopes(token)
+			if err != nil {
+				t.Fatalf("ParseTokenScopes() error = %v", err)
+			}
+			if userId != "user-1" || !slices.Equal(scopes, tt.wantScopes) {
+				t.Errorf("ParseTokenScopes() = %s, %v, want user-1, %v", userId, scopes, tt.wantScopes)
+			}
+		})
+	}
+}
+
+func TestParseTokenScopes_DisabledUser(t *testing.T) {
+	keys, err := NewTokenKeys(TokenSettings{Secret: "secret", TTL: time.Hour})
+	if err != nil {
+		t.Fatalf("NewTokenKeys() error = %v", err)
+	}
+	token, err := keys.Sign(testClaims())
+	if err != nil {
+		t.Fatalf("Sign() error = %v", err)
+	}
+
+	s := NewAuthService(&fakeUsers{user: model.User{Username: "alice", Disabled: true}}, keys)
+	if _, _, err := s.ParseTokenScopes(token); err == nil {
+		t.Error("ParseTokenScopes() for a disabled user expected an error")
+	}
+}
@@ -0,0 +1,343 @@
+package service
+
+import (
+	"TaskManager/internal/domain/model"
+	"TaskManager/internal/repository"
+	"errors"
+	"fmt"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"strings"
+	"time"
+)
+
+// positionDigits are the digits of fractional-index positions, in ascending byte order
+// so positions sort correctly as plain strings.
+const positionDigits = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+// BoardService manages kanban boards and the placement of task lists on them.
+type BoardService struct {
+	repo       repository.Board
+	lists      repository.TaskList
+	workspaces repository.Workspace
+	events     EventPublisher
+}
+
+// NewBoardService initializes a new BoardService with the provided repositories.
+// Moves of task lists are published to events.
+func NewBoardService(repo repository.Board, lists repository.TaskList, workspaces repository.Workspace, events EventPublisher) *BoardService {
+	return &BoardService{
+		repo:       repo,
+		lists:      lists,
+		workspaces: workspaces,
+		events:     events,
+	}
+}
+
+// Create creates a board for the user, inside a workspace when one is given.
+func (s *BoardService) Create(userId string, input model.BoardInput) (string, error) {
+	name := strings.TrimSpace(input.Name)
+	if name == "" {
+		return "", errors.New("board name cannot be empty")
+	}
+	if input.WorkspaceId != "" {
+		if _, err := s.workspaces.GetById(userId, input.WorkspaceId); err != nil {
+			return "", err
+		}
+	}
+
+	board := model.Board{
+		UserId:      userId,
+		WorkspaceId: input.WorkspaceId,
+		Name:        name,
+		Columns:     []model.BoardColumn{},
+		CreatedAt:   time.Now(),
+	}
+	for _, columnName := range input.Columns {
+		column, err := newBoardColumn(columnName)
+		if err != nil {
+			return "", err
+		}
+		board.Columns = append(board.Columns, column)
+	}
+	return s.repo.Create(board)
+}
+
+// GetAll retrieves the boards the user can access.
+func (s *BoardService) GetAll(userId string) ([]model.Board, error) {
+	return s.repo.GetAll(userId)
+}
+
+// GetById retrieves a board with the task lists of each column in order.
+func (s *BoardService) GetById(userId string, id string) (model.BoardView, error) {
+	board, err := s.repo.GetById(userId, id)
+	if err != nil {
+		return model.BoardView{}, err
+	}
+	lists, err := s.lists.GetByBoard(userId, id)
+	if err != nil {
+		return model.BoardView{}, err
+	}
+
+	view := model.BoardView{Board: board, Columns: make([]model.BoardColumnView, 0, len(board.Columns))}
+	for _, column := range board.Columns {
+		columnView := model.BoardColumnView{BoardColumn: column, Lists: []model.TaskList{}}
+		for _, list := range lists {
+			if list.ColumnId == column.Id {
+				columnView.Lists = append(columnView.Lists, list)
+			}
+		}
+		view.Columns = append(view.Columns, columnView)
+	}
+	return view, nil
+}
+
+// Delete removes a board and takes its task lists off it.
+func (s *BoardService) Delete(userId string, id string) error {
+	board, err := s.repo.GetById(userId, id)
+	if err != nil {
+		return err
+	}
+	if err := authorizeBoardWrite(s.workspaces, userId, board); err != nil {
+		return err
+	}
+	if err := s.lists.DetachBoard(id, ""); err != nil {
+		return err
+	}
+	return s.repo.Delete(id)
+}
+
+// AddColumn appends a column to a board and returns its ID.
+func (s *BoardService) AddColumn(userId string, id string, input model.BoardColumnInput) (string, error) {
+	board, err := s.repo.GetById(userId, id)
+	if err != nil {
+		return "", err
+	}
+	if err := authorizeBoardWrite(s.workspaces, userId, board); err != nil {
+		return "", err
+	}
+	column, err := newBoardColumn(input.Name)
+	if err != nil {
+		return "", err
+	}
+	if err := s.repo.AddColumn(id, column); err != nil {
+		return "", err
+	}
+	return column.Id, nil
+}
+
+// RemoveColumn removes a column from a board and takes its task lists off the board.
+func (s *BoardService) RemoveColumn(userId string, id string, columnId string) error {
+	board, err := s.repo.GetById(userId, id)
+	if err != nil {
+		return err
+	}
+	if err := authorizeBoardWrite(s.workspaces, userId, board); err != nil {
+		return err
+	}
+	if !hasColumn(board, columnId) {
+		return fmt.Errorf("column %s not found on board %s", columnId, id)
+	}
+	if err := s.lists.DetachBoard(id, columnId); err != nil {
+		return err
+	}
+	return s.repo.RemoveColumn(id, columnId)
+}
+
+// Move places a task list in a board column between its requested neighbours.
+// Only the moved list is written, because its new position is computed between the neighbours' positions.
+func (s *BoardService) Move(userId string, listId int, input model.MoveTaskListInput) error {
+	board, err := s.repo.GetById(userId, input.BoardId)
+	if err != nil {
+		return err
+	}
+	if !hasColumn(board, input.ColumnId) {
+		return fmt.Errorf("column %s not found on board %s", input.ColumnId, input.BoardId)
+	}
+	list, err := s.lists.GetById(userId, listId)
+	if err != nil {
+		return err
+	}
+	if err := authorizeListWrite(s.workspaces, userId, list); err != nil {
+		return err
+	}
+	// The list takes over the workspace of the board, so a move across workspaces must be allowed in both.
+	if board.WorkspaceId != list.WorkspaceId {
+		if board.WorkspaceId == "" {
+			if list.UserId != userId {
+				return errors.New("only the creator of a task list can move it out of its workspace")
+			}
+		} else if _, err := authorizeWorkspace(s.workspaces, userId, board.WorkspaceId, model.RoleMember); err != nil {
+			return err
+		}
+	}
+
+	lists, err := s.lists.GetByBoard(userId, input.BoardId)
+	if err != nil {
+		return err
+	}
+	var column []model.TaskList
+	for _, list := range lists {
+		if list.ColumnId == input.ColumnId && list.Id != listId {
+			column = append(column, list)
+		}
+	}
+
+	lower, upper, err := neighbourPositions(column, input.AfterId, input.BeforeId)
+	if err != nil {
+		return err
+	}
+	position, err := positionBetween(lower, upper)
+	if err != nil {
+		return err
+	}
+
+	if err := s.lists.Move(userId, listId, input.BoardId, input.ColumnId, position, board.WorkspaceId); err != nil {
+		return err
+	}
+
+	if list, err := s.lists.GetById(userId, listId); err == nil {
+		publishTaskListEvent(s.workspaces, s.events, model.EventTaskListUpdated, userId, list)
+	}
+	return nil
+}
+
+// neighbourPositions returns the positions the moved list must sort between, given the ordered lists
+// of the target column and the IDs of the requested neighbours. Zero IDs mean no neighbour on that side;
+// when both are zero the list goes to the end of the column.
+func neighbourPositions(column []model.TaskList, afterId, beforeId int) (string, string, error) {
+	indexOf := func(id int) int {
+		for i, list := range column {
+			if list.Id == id {
+				return i
+			}
+		}
+		return -1
+	}
+
+	switch {
+	case afterId != 0 && beforeId != 0:
+		after, before := indexOf(afterId), indexOf(beforeId)
+		if after < 0 || before < 0 {
+			return "", "", errors.New("neighbour task lists must be in the target column")
+		}
+		if after+1 != before {
+			return "", "", errors.New("neighbour task lists must be adjacent")
+		}
+		return column[after].Position, column[before].Position, nil
+	case afterId != 0:
+		after := indexOf(afterId)
+		if after < 0 {
+			return "", "", errors.New("neighbour task lists must be in the target column")
+		}
+		if after+1 < len(column) {
+			return column[after].Position, column[after+1].Position, nil
+		}
+		return column[after].Position, "", nil
+	case beforeId != 0:
+		before := indexOf(beforeId)
+		if before < 0 {
+			return "", "", errors.New("neighbour task lists must be in the target column")
+		}
+		if before > 0 {
+			return column[before-1].Position, column[before].Position, nil
+		}
+		return "", column[before].Position, nil
+	case len(column) > 0:
+		return column[len(column)-1].Position, "", nil
+	default:
+		return "", "", nil
+	}
+}
+
+// positionBetween returns a fractional-index position that sorts strictly between lower and upper.
+// An empty lower means the start of the column and an empty upper means its end.
+func positionBetween(lower, upper string) (string, error) {
+	if upper != "" && lower >= upper {
+		return "", fmt.Errorf("position %q must sort before %q", lower, upper)
+	}
+	if strings.HasSuffix(lower, positionDigits[:1]) || strings.HasSuffix(upper, positionDigits[:1]) {
+		return "", errors.New("positions cannot end with the zero digit")
+	}
+	return midpoint(lower, upper), nil
+}
+
+// midpoint computes the position between lower and upper digit by digit.
+// It never produces a trailing zero digit, so there is always room for another position below it.
+func midpoint(lower, upper string) string {
+	if upper != "" {
+		// Keep the common prefix, reading missing digits of lower as zero.
+		n := 0
+		for n < len(upper) && digitAt(lower, n) == upper[n] {
+			n++
+		}
+		if n > 0 {
+			rest := ""
+			if n < len(lower) {
+				rest = lower[n:]
+			}
+			return upper[:n] + midpoint(rest, upper[n:])
+		}
+	}
+
+	lowDigit := 0
+	if lower != "" {
+		lowDigit = strings.IndexByte(positionDigits, lower[0])
+	}
+	highDigit := len(positionDigits)
+	if upper != "" {
+		highDigit = strings.IndexByte(positionDigits, upper[0])
+	}
+
+	if highDigit-lowDigit > 1 {
+		return string(positionDigits[(lowDigit+highDigit+1)/2])
+	}
+	if len(upper) > 1 {
+		return upper[:1]
+	}
+	rest := ""
+	if lower != "" {
+		rest = lower[1:]
+	}
+	return string(positionDigits[lowDigit]) + midpoint(rest, "")
+}
+
+// digitAt returns the digit of the position at index i, or the zero digit past its end.
+func digitAt(position string, i int) byte {
+	if i < len(position) {
+		return position[i]
+	}
+	return positionDigits[0]
+}
+
+// authorizeBoardWrite checks that the user may delete a board or change its columns.
+// In a workspace that is the board's creator or an owner or admin of the workspace.
+func authorizeBoardWrite(workspaces repository.Workspace, userId string, board model.Board) error {
+	if board.WorkspaceId == "" {
+		return nil
+	}
+	required := model.RoleAdmin
+	if board.UserId == userId {
+		required = model.RoleMember
+	}
+	_, err := authorizeWorkspace(workspaces, userId, board.WorkspaceId, required)
+	return err
+}
+
+// hasColumn reports whether the board has a column with the given ID.
+func hasColumn(board model.Board, columnId string) bool {
+	for _, column := range board.Columns {
+		if column.Id == columnId {
+			return true
+		}
+	}
+	return false
+}
+
+// newBoardColumn creates a column with a fresh ID.
+func newBoardColumn(name string) (model.BoardColumn, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return model.BoardColumn{}, errors.New("column name cannot be empty")
+	}
+	return model.BoardColumn{Id: bson.NewObjectID().Hex(), Name: name}, nil
+}
@@ -0,0 +1,197 @@
+package service
+
+import (
+	"TaskManager/internal/domain/model"
+	"TaskManager/internal/repository"
+	"errors"
+	"testing"
+)
+
+// fakeBoards keeps boards in memory by ID.
+type fakeBoards struct {
+	repository.Board
+	boards map[string]model.Board
+}
+
+func (f *fakeBoards) GetById(userId string, id string) (model.Board, error) {
+	board, ok := f.boards[id]
+	if !ok {
+		return model.Board{}, errors.New("board not found")
+	}
+	return board, nil
+}
+
+func TestPositionBetween(t *testing.T) {
+	testTable := []struct {
+		name    string
+		lower   string
+		upper   string
+		wantErr bool
+	}{
+		{name: "Empty Column", lower: "", upper: ""},
+		{name: "Append", lower: "V", upper: ""},
+		{name: "Prepend", lower: "", upper: "V"},
+		{name: "Between Distant", lower: "A", upper: "z"},
+		{name: "Between Adjacent Digits", lower: "A", upper: "B"},
+		{name: "Common Prefix", lower: "Ab", upper: "Ac"},
+		{name: "Before Single Smallest Digit", lower: "", upper: "1"},
+		{name: "After Largest Digit", lower: "z", upper: ""},
+		{name: "Reversed Bounds", lower: "B", upper: "A", wantErr: true},
+		{name: "Equal Bounds", lower: "B", upper: "B", wantErr: true},
+		{name: "Trailing Zero", lower: "A0", upper: "", wantErr: true},
+	}
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := positionBetween(tt.lower, tt.upper)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("positionBetween(%q, %q) error = %v, wantErr %v", tt.lower, tt.upper, err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if result <= tt.lower || (tt.upper != "" && result >= tt.upper) {
+				t.Errorf("positionBetween(%q, %q) = %q, not strictly between", tt.lower, tt.upper, result)
+			}
+		})
+	}
+}
+
+func TestPositionBetween_RepeatedInserts(t *testing.T) {
+	// Repeatedly inserting at the front and between the same neighbours must keep producing ordered positions.
+	lower, upper := "", "V"
+	for i := 0; i < 200; i++ {
+		position, err := positionBetween(lower, upper)
+		if err != nil {
+			t.Fatalf("insert %d: unexpected error: %v", i, err)
+		}
+		if position <= lower || position >= upper {
+			t.Fatalf("insert %d: %q is not between %q and %q", i, position, lower, upper)
+		}
+		if i%2 == 0 {
+			upper = position
+		} else {
+			lower = position
+		}
+	}
+}
+
+func TestNeighbourPositions(t *testing.T) {
+	column := []model.TaskList{
+		{Id: 1, Position: "A"},
+		{Id: 2, Position: "M"},
+		{Id: 3, Position: "T"},
+	}
+
+	testTable := []struct {
+		name     string
+		afterId  int
+		beforeId int
+		lower    string
+		upper    string
+		wantErr  bool
+	}{
+		{name: "Append", lower: "T", upper: ""},
+		{name: "After First", afterId: 1, lower: "A", upper: "M"},
+		{name: "After Last", afterId: 3, lower: "T", upper: ""},
+		{name: "Before First", beforeId: 1, lower: "", upper: "A"},
+		{name: "Between Adjacent", afterId: 2, beforeId: 3, lower: "M", upper: "T"},
+		{name: "Not Adjacent", afterId: 1, beforeId: 3, wantErr: true},
+		{name: "Unknown Neighbour", afterId: 9, wantErr: true},
+	}
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			lower, upper, err := neighbourPositions(column, tt.afterId, tt.beforeId)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("neighbourPositions() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if lower != tt.lower || upper != tt.upper {
+				t.Errorf("neighbourPositions() = (%q, %q), want (%q, %q)", lower, upper, tt.lower, tt.upper)
+			}
+		})
+	}
+}
+
+func TestAuthorizeBoardWrite(t *testing.T) {
+	workspaces := &fakeWorkspaces{workspaces: map[string]model.Workspace{
+		"ws": {Members: []model.WorkspaceMember{
+			{UserId: "alice", Role: model.RoleOwner},
+			{UserId: "bob", Role: model.RoleMember},
+			{UserId: "carol", Role: model.RoleAdmin},
+		}},
+	}}
+
+	testTable := []struct {
+		name    string
+		userId  string
+		board   model.Board
+		wantErr bool
+	}{
+		{
+			name:    "Personal Board",
+			userId:  "bob",
+			board:   model.Board{UserId: "bob"},
+			wantErr: false,
+		},
+		{
+			name:    "Creator Member",
+			userId:  "bob",
+			board:   model.Board{UserId: "bob", WorkspaceId: "ws"},
+			wantErr: false,
+		},
+		{
+			name:    "Workspace Admin",
+			userId:  "carol",
+			board:   model.Board{UserId: "bob", WorkspaceId: "ws"},
+			wantErr: false,
+		},
+		{
+			name:    "Other Member",
+			userId:  "bob",
+			board:   model.Board{UserId: "alice", WorkspaceId: "ws"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			err := authorizeBoardWrite(workspaces, tt.userId, tt.board)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("authorizeBoardWrite() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMove_Authorization(t *testing.T) {
+	// carol is a member of w2 but has left w1, where she created list 1. bob is a member of w1 only.
+	workspaces := &fakeWorkspaces{workspaces: map[string]model.Workspace{
+		"w1": {Members: []model.WorkspaceMember{{UserId: "alice", Role: model.RoleOwner}, {UserId: "bob", Role: model.RoleMember}}},
+		"w2": {Members: []model.WorkspaceMember{{UserId: "alice", Role: model.RoleOwner}, {UserId: "carol", Role: model.RoleMember}}},
+	}}
+	boards := &fakeBoards{boards: map[string]model.Board{
+		"b2":       {WorkspaceId: "w2", Columns: []model.BoardColumn{{Id: "todo"}}},
+		"personal": {UserId: "bob", Columns: []model.BoardColumn{{Id: "todo"}}},
+	}}
+	lists := &fakeDependencyLists{
+		lists:   map[int]model.TaskList{1: {Id: 1, UserId: "carol", WorkspaceId: "w1"}},
+		visible: map[string][]int{"carol": {1}, "bob": {1}},
+	}
+	s := NewBoardService(boards, lists, workspaces, discardEvents{})
+
+	testTable := []struct {
+		name    string
+		userId  string
+		boardId string
+	}{
+		{name: "Former Member Moves Out Of The Workspace", userId: "carol", boardId: "b2"},
+		{name: "Member Moves Into A Workspace They Are Not In", userId: "bob", boardId: "b2"},
+		{name: "Member Moves Another User's List To A Personal Board", userId: "bob", boardId: "personal"},
+	}
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			err := s.Move(tt.userId, 1, model.MoveTaskListInput{BoardId: tt.boardId, ColumnId: "todo"})
+			if err == nil {
+				t.Error("Move() expected an error")
+			}
+		})
+	}
+}
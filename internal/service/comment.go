@@ -0,0 +1,244 @@
+package service
+
+import (
+	"TaskManager/internal/domain/model"
+	"TaskManager/internal/repository"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	maxCommentLength = 10000
+	defaultPageLimit = 20
+	maxPageLimit     = 100
+)
+
+// mentionPattern matches @username where the @ does not continue a word, so e-mail addresses are not mentions.
+var mentionPattern = regexp.MustCompile(`(?:^|[^A-Za-z0-9@])@([A-Za-z0-9]+)`)
+
+// CommentService manages the discussion of task lists and the mentions made in it.
+type CommentService struct {
+	repo  repository.Comment
+	lists repository.TaskList
+	users repository.Authorization
+}
+
+// NewCommentService initializes a new CommentService with the provided repositories.
+func NewCommentService(repo repository.Comment, lists repository.TaskList, users repository.Authorization) *CommentService {
+	return &CommentService{
+		repo:  repo,
+		lists: lists,
+		users: users,
+	}
+}
+
+// CreateComment posts a comment on a task list the user can access and returns its ID.
+// A reply to a reply joins the thread of the comment it answers.
+func (s *CommentService) CreateComment(userId string, listId int, input model.CommentInput) (string, error) {
+	body, err := validateCommentBody(input.Body)
+	if err != nil {
+		return "", err
+	}
+	if _, err := s.lists.GetById(userId, listId); err != nil {
+		return "", err
+	}
+
+	comment := model.Comment{
+		ListId:    listId,
+		UserId:    userId,
+		Body:      body,
+		CreatedAt: time.Now(),
+	}
+	if input.ParentId != "" {
+		parent, err := s.repo.GetById(listId, input.ParentId)
+		if err != nil {
+			return "", err
+		}
+		comment.ParentId = parent.Id.Hex()
+		if parent.ParentId != "" {
+			comment.ParentId = parent.ParentId
+		}
+	}
+	comment.Mentions = s.resolveMentions(listId, body)
+
+	id, err := s.repo.Create(comment)
+	if err != nil {
+		return "", err
+	}
+	if err := s.recordMentions(userId, listId, id, comment.Mentions); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// GetComments retrieves a page of the comment threads of a task list, oldest first, and the total number of threads.
+func (s *CommentService) GetComments(userId string, listId int, page model.Page) ([]model.CommentThread, int64, error) {
+	page = normalizePage(page)
+	if _, err := s.lists.GetById(userId, listId); err != nil {
+		return nil, 0, err
+	}
+
+	comments, total, err := s.repo.GetThreads(listId, page)
+	if err != nil {
+		return nil, 0, err
+	}
+	parentIds := make([]string, 0, len(comments))
+	for _, comment := range comments {
+		parentIds = append(parentIds, comment.Id.Hex())
+	}
+	replies, err := s.repo.GetReplies(listId, parentIds)
+	if err != nil {
+		return nil, 0, err
+	}
+	return groupCommentThreads(comments, replies), total, nil
+}
+
+// UpdateComment edits the body of a comment. Only its author can edit it, and only newly mentioned users are notified.
+func (s *CommentService) UpdateComment(userId string, listId int, commentId string, input model.CommentInput) error {
+	body, err := validateCommentBody(input.Body)
+	if err != nil {
+		return err
+	}
+	comment, err := s.authorComment(userId, listId, commentId)
+	if err != nil {
+		return err
+	}
+
+	mentions := s.resolveMentions(listId, body)
+	if err := s.repo.Update(commentId, body, mentions); err != nil {
+		return err
+	}
+
+	var added []string
+	for _, mentioned := range mentions {
+		if !slices.Contains(comment.Mentions, mentioned) {
+			added = append(added, mentioned)
+		}
+	}
+	return s.recordMentions(userId, listId, commentId, added)
+}
+
+// DeleteComment removes a comment and its replies. Only its author can delete it.
+func (s *CommentService) DeleteComment(userId string, listId int, commentId string) error {
+	if _, err := s.authorComment(userId, listId, commentId); err != nil {
+		return err
+	}
+	return s.repo.Delete(commentId)
+}
+
+// GetMentions retrieves a page of the user's mention notifications, newest first, and their total number.
+func (s *CommentService) GetMentions(userId string, page model.Page) ([]model.Mention, int64, error) {
+	return s.repo.GetMentions(userId, normalizePage(page))
+}
+
+// authorComment returns a comment on a task list the user can access, when the user wrote it.
+func (s *CommentService) authorComment(userId string, listId int, commentId string) (model.Comment, error) {
+	if _, err := s.lists.GetById(userId, listId); err != nil {
+		return model.Comment{}, err
+	}
+	comment, err := s.repo.GetById(listId, commentId)
+	if err != nil {
+		return model.Comment{}, err
+	}
+	if comment.UserId != userId {
+		return model.Comment{}, errors.New("only the author can change a comment")
+	}
+	return comment, nil
+}
+
+// resolveMentions returns the IDs of the mentioned users who can access the task list.
+// Unknown usernames and users without access are ignored.
+func (s *CommentService) resolveMentions(listId int, body string) []string {
+	var userIds []string
+	for _, username := range parseMentions(body) {
+		user, err := s.users.GetUserByUsername(username)
+		if err != nil {
+			continue
+		}
+		userId := user.Id.Hex()
+		if _, err := s.lists.GetById(userId, listId); err != nil {
+			continue
+		}
+		userIds = append(userIds, userId)
+	}
+	return userIds
+}
+
+// recordMentions stores a mention notification for each mentioned user other than the author.
+func (s *CommentService) recordMentions(authorId string, listId int, commentId string, userIds []string) error {
+	var mentions []model.Mention
+	for _, userId := range userIds {
+		if userId == authorId {
+			continue
+		}
+		mentions = append(mentions, model.Mention{
+			UserId:    userId,
+			AuthorId:  authorId,
+			ListId:    listId,
+			CommentId: commentId,
+			CreatedAt: time.Now(),
+		})
+	}
+	return s.repo.CreateMentions(mentions)
+}
+
+// parseMentions returns the distinct usernames mentioned in a comment body, in order of appearance.
+func parseMentions(body string) []string {
+	var usernames []string
+	for _, match := range mentionPattern.FindAllStringSubmatch(body, -1) {
+		username := match[1]
+		if len(username) < 3 || len(username) > 30 {
+			continue
+		}
+		if !slices.Contains(usernames, username) {
+			usernames = append(usernames, username)
+		}
+	}
+	return usernames
+}
+
+// groupCommentThreads attaches the replies to their top-level comments, keeping both in order.
+func groupCommentThreads(comments []model.Comment, replies []model.Comment) []model.CommentThread {
+	threads := make([]model.CommentThread, 0, len(comments))
+	index := make(map[string]int, len(comments))
+	for i, comment := range comments {
+		threads = append(threads, model.CommentThread{Comment: comment, Replies: []model.Comment{}})
+		index[comment.Id.Hex()] = i
+	}
+	for _, reply := range replies {
+		if i, ok := index[reply.ParentId]; ok {
+			threads[i].Replies = append(threads[i].Replies, reply)
+		}
+	}
+	return threads
+}
+
+// normalizePage applies the default page size and caps it at the maximum.
+func normalizePage(page model.Page) model.Page {
+	if page.Offset < 0 {
+		page.Offset = 0
+	}
+	if page.Limit <= 0 {
+		page.Limit = defaultPageLimit
+	}
+	if page.Limit > maxPageLimit {
+		page.Limit = maxPageLimit
+	}
+	return page
+}
+
+// validateCommentBody checks the comment body and returns it trimmed.
+func validateCommentBody(body string) (string, error) {
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return "", errors.New("comment cannot be empty")
+	}
+	if len(body) > maxCommentLength {
+		return "", fmt.Errorf("comment cannot be longer than %d characters", maxCommentLength)
+	}
+	return body, nil
+}
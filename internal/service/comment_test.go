@@ -0,0 +1,94 @@
+package service
+
+import (
+	"TaskManager/internal/domain/model"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseMentions(t *testing.T) {
+	testTable := []struct {
+		name string
+		body string
+		want []string
+	}{
+		{name: "Single", body: "@alice please review", want: []string{"alice"}},
+		{name: "Several In Order", body: "cc @bob and @alice", want: []string{"bob", "alice"}},
+		{name: "Duplicates", body: "@bob @bob", want: []string{"bob"}},
+		{name: "Punctuation", body: "thanks (@carol), @dave!", want: []string{"carol", "dave"}},
+		{name: "Email Address", body: "mail bob@example.com", want: nil},
+		{name: "Double At", body: "@@bob", want: nil},
+		{name: "Too Short", body: "@ab", want: nil},
+		{name: "No Mentions", body: "plain text", want: nil},
+	}
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseMentions(tt.body); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseMentions(%q) = %v, want %v", tt.body, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGroupCommentThreads(t *testing.T) {
+	first, second := bson.NewObjectID(), bson.NewObjectID()
+	comments := []model.Comment{{Id: first, Body: "first"}, {Id: second, Body: "second"}}
+	replies := []model.Comment{
+		{ParentId: second.Hex(), Body: "reply to second"},
+		{ParentId: first.Hex(), Body: "reply to first"},
+		{ParentId: second.Hex(), Body: "another reply to second"},
+		{ParentId: bson.NewObjectID().Hex(), Body: "reply to a thread on another page"},
+	}
+
+	threads := groupCommentThreads(comments, replies)
+	if len(threads) != 2 {
+		t.Fatalf("got %d threads, want 2", len(threads))
+	}
+	if len(threads[0].Replies) != 1 || threads[0].Replies[0].Body != "reply to first" {
+		t.Errorf("first thread replies = %v", threads[0].Replies)
+	}
+	if len(threads[1].Replies) != 2 || threads[1].Replies[1].Body != "another reply to second" {
+		t.Errorf("second thread replies = %v", threads[1].Replies)
+	}
+}
+
+func TestNormalizePage(t *testing.T) {
+	testTable := []struct {
+		name string
+		page model.Page
+		want model.Page
+	}{
+		{name: "Defaults", page: model.Page{}, want: model.Page{Offset: 0, Limit: defaultPageLimit}},
+		{name: "Kept", page: model.Page{Offset: 40, Limit: 10}, want: model.Page{Offset: 40, Limit: 10}},
+		{name: "Capped", page: model.Page{Limit: 1000}, want: model.Page{Limit: maxPageLimit}},
+		{name: "Negative Offset", page: model.Page{Offset: -5, Limit: 10}, want: model.Page{Limit: 10}},
+	}
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := normalizePage(tt.page); got != tt.want {
+				t.Errorf("normalizePage(%v) = %v, want %v", tt.page, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateCommentBody(t *testing.T) {
+	testTable := []struct {
+		name    string
+		body    string
+		wantErr bool
+	}{
+		{name: "Valid", body: "looks good"},
+		{name: "Empty", body: "  ", wantErr: true},
+		{name: "Too Long", body: strings.Repeat("a", maxCommentLength+1), wantErr: true},
+	}
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := validateCommentBody(tt.body); (err != nil) != tt.wantErr {
+				t.Errorf("validateCommentBody() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
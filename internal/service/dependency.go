@@ -0,0 +1,258 @@
+package service
+
+import (
+	"TaskManager/internal/domain/model"
+	"TaskManager/internal/repository"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// DependencyService manages "blocked by" relationships between task lists.
+type DependencyService struct {
+	repo       repository.TaskList
+	workspaces repository.Workspace
+	events     EventPublisher
+}
+
+// NewDependencyService initializes a new DependencyService with the provided repositories.
+// Changes to dependencies are published to events.
+func NewDependencyService(repo repository.TaskList, workspaces repository.Workspace, events EventPublisher) *DependencyService {
+	return &DependencyService{
+		repo:       repo,
+		workspaces: workspaces,
+		events:     events,
+	}
+}
+
+// AddDependency makes a task list depend on another one the user can access.
+// It is rejected when the other list already depends on this one, directly or through other lists.
+func (s *DependencyService) AddDependency(userId string, listId int, dependsOnId int) error {
+	if listId == dependsOnId {
+		return errors.New("a task list cannot depend on itself")
+	}
+	list, err := s.repo.GetById(userId, listId)
+	if err != nil {
+		return err
+	}
+	if err := authorizeListWrite(s.workspaces, userId, list); err != nil {
+		return err
+	}
+	if _, err := s.repo.GetById(userId, dependsOnId); err != nil {
+		return err
+	}
+
+	edges, err := s.dependencyGraph(dependsOnId)
+	if err != nil {
+		return err
+	}
+	if path := dependencyPath(edges, dependsOnId, listId); path != nil {
+		visible, err := visibleListIds(s.repo, userId, path)
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("dependency would create a cycle: %s", formatDependencyCycle(strconv.Itoa(listId), maskListIds(path, visible)))
+	}
+
+	if err := s.repo.AddDependency(userId, listId, dependsOnId); err != nil {
+		return err
+	}
+	publishUpdated(s.repo, s.workspaces, s.events, userId, listId)
+	return nil
+}
+
+// RemoveDependency removes a dependency from a task list.
+func (s *DependencyService) RemoveDependency(userId string, listId int, dependsOnId int) error {
+	list, err := s.repo.GetById(userId, listId)
+	if err != nil {
+		return err
+	}
+	if err := authorizeListWrite(s.workspaces, userId, list); err != nil {
+		return err
+	}
+	if err := s.repo.RemoveDependency(userId, listId, dependsOnId); err != nil {
+		return err
+	}
+	publishUpdated(s.repo, s.workspaces, s.events, userId, listId)
+	return nil
+}
+
+// GetDependencies retrieves the task lists a task list depends on.
+func (s *DependencyService) GetDependencies(userId string, listId int) ([]model.TaskList, error) {
+	list, err := s.repo.GetById(userId, listId)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.GetByIds(userId, list.DependsOn)
+}
+
+// GetDependents retrieves the task lists that depend on a task list.
+func (s *DependencyService) GetDependents(userId string, listId int) ([]model.TaskList, error) {
+	if _, err := s.repo.GetById(userId, listId); err != nil {
+		return nil, err
+	}
+	return s.repo.GetDependents(userId, listId)
+}
+
+// dependencyGraph loads the dependencies reachable from a task list, one level per query.
+// The walk includes lists the user cannot access, so a cycle through them is still found.
+func (s *DependencyService) dependencyGraph(listId int) (map[int][]int, error) {
+	edges := map[int][]int{}
+	requested := map[int]bool{listId: true}
+	frontier := []int{listId}
+	for len(frontier) > 0 {
+		lists, err := s.repo.GetDependencyNodes(frontier)
+		if err != nil {
+			return nil, err
+		}
+		frontier = nil
+		for _, list := range lists {
+			edges[list.Id] = list.DependsOn
+			for _, id := range list.DependsOn {
+				if !requested[id] {
+					requested[id] = true
+					frontier = append(frontier, id)
+				}
+			}
+		}
+	}
+	return edges, nil
+}
+
+// ensureUnblocked returns an error naming the open task lists a task list depends on.
+// Open dependencies the user can no longer access still block it, but only their number is reported.
+func ensureUnblocked(repo repository.TaskList, userId string, listId int) error {
+	list, err := repo.GetById(userId, listId)
+	if err != nil {
+		return err
+	}
+	blockers, err := repo.GetDependencyNodes(list.DependsOn)
+	if err != nil {
+		return err
+	}
+
+	var open []int
+	for _, blocker := range blockers {
+		if blocker.Status != model.StatusDone {
+			open = append(open, blocker.Id)
+		}
+	}
+	if len(open) == 0 {
+		return nil
+	}
+
+	visible, err := visibleListIds(repo, userId, open)
+	if err != nil {
+		return err
+	}
+	return fmt.Errorf("task list %d is blocked by %s", listId, describeBlockers(visible, len(open)-len(visible)))
+}
+
+// visibleListIds returns the IDs the user can access, keeping their order.
+func visibleListIds(repo repository.TaskList, userId string, ids []int) ([]int, error) {
+	lists, err := repo.GetByIds(userId, ids)
+	if err != nil {
+		return nil, err
+	}
+	return slices.DeleteFunc(slices.Clone(ids), func(id int) bool {
+		return !slices.ContainsFunc(lists, func(list model.TaskList) bool { return list.Id == id })
+	}), nil
+}
+
+// maskListIds formats task list IDs, replacing the ones that are not visible with a placeholder.
+func maskListIds(ids []int, visible []int) []string {
+	masked := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if slices.Contains(visible, id) {
+			masked = append(masked, strconv.Itoa(id))
+		} else {
+			masked = append(masked, "...")
+		}
+	}
+	return masked
+}
+
+// describeBlockers names the open task lists the user can see and counts the ones they cannot.
+func describeBlockers(visible []int, hidden int) string {
+	var parts []string
+	if len(visible) > 0 {
+		ids := make([]string, 0, len(visible))
+		for _, id := range visible {
+			ids = append(ids, strconv.Itoa(id))
+		}
+		parts = append(parts, "open task lists "+strings.Join(ids, ", "))
+	}
+	switch {
+	case hidden == 1:
+		parts = append(parts, "1 open task list you cannot access")
+	case hidden > 1:
+		parts = append(parts, fmt.Sprintf("%d open task lists you cannot access", hidden))
+	}
+	return strings.Join(parts, " and ")
+}
+
+// normalizeDependencies drops duplicate dependency IDs and checks that the user can access each of them.
+func normalizeDependencies(repo repository.TaskList, userId string, dependsOn []int) ([]int, error) {
+	if len(dependsOn) == 0 {
+		return nil, nil
+	}
+	ids := slices.Clone(dependsOn)
+	slices.Sort(ids)
+	ids = slices.Compact(ids)
+
+	lists, err := repo.GetByIds(userId, ids)
+	if err != nil {
+		return nil, err
+	}
+	if len(lists) != len(ids) {
+		for _, id := range ids {
+			if !slices.ContainsFunc(lists, func(list model.TaskList) bool { return list.Id == id }) {
+				return nil, fmt.Errorf("task list with ID %d not found for user %s", id, userId)
+			}
+		}
+	}
+	return ids, nil
+}
+
+// dependencyPath returns a path of dependencies leading from one node to another, or nil when there is none.
+// Adding the dependency to -> from creates a cycle exactly when such a path exists.
+func dependencyPath[K comparable](edges map[K][]K, from, to K) []K {
+	visited := map[K]bool{}
+	var visit func(node K) []K
+	visit = func(node K) []K {
+		if node == to {
+			return []K{node}
+		}
+		if visited[node] {
+			return nil
+		}
+		visited[node] = true
+		for _, next := range edges[node] {
+			if path := visit(next); path != nil {
+				return append([]K{node}, path...)
+			}
+		}
+		return nil
+	}
+	return visit(from)
+}
+
+// formatDependencyCycle describes the cycle formed by a new dependency of start and the path leading back to it.
+func formatDependencyCycle[K comparable](start K, path []K) string {
+	parts := []string{fmt.Sprint(start)}
+	for _, node := range path {
+		parts = append(parts, fmt.Sprint(node))
+	}
+	return strings.Join(parts, " -> ")
+}
+
+// publishUpdated notifies subscribers that a task list changed, using its stored state.
+func publishUpdated(repo repository.TaskList, workspaces repository.Workspace, events EventPublisher, userId string, listId int) {
+	list, err := repo.GetById(userId, listId)
+	if err != nil {
+		list = model.TaskList{Id: listId, UserId: userId}
+	}
+	publishTaskListEvent(workspaces, events, model.EventTaskListUpdated, userId, list)
+}
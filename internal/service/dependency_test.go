@@ -0,0 +1,157 @@
+package service
+
+import (
+	"TaskManager/internal/domain/model"
+	"TaskManager/internal/repository"
+	"errors"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// fakeDependencyLists keeps task lists in memory and lets each user see only some of them.
+type fakeDependencyLists struct {
+	repository.TaskList
+	lists   map[int]model.TaskList
+	visible map[string][]int
+}
+
+func (f *fakeDependencyLists) GetById(userId string, listId int) (model.TaskList, error) {
+	if !slices.Contains(f.visible[userId], listId) {
+		return model.TaskList{}, errors.New("task list not found")
+	}
+	return f.lists[listId], nil
+}
+
+func (f *fakeDependencyLists) GetByIds(userId string, listIds []int) ([]model.TaskList, error) {
+	lists := []model.TaskList{}
+	for _, id := range listIds {
+		if list, ok := f.lists[id]; ok && slices.Contains(f.visible[userId], id) {
+			lists = append(lists, list)
+		}
+	}
+	return lists, nil
+}
+
+func (f *fakeDependencyLists) GetDependencyNodes(listIds []int) ([]model.TaskList, error) {
+	lists := []model.TaskList{}
+	for _, id := range listIds {
+		if list, ok := f.lists[id]; ok {
+			lists = append(lists, list)
+		}
+	}
+	return lists, nil
+}
+
+func TestDependencyPath(t *testing.T) {
+	// 1 depends on 2, 2 on 3 and 4, 4 on 5.
+	edges := map[int][]int{
+		1: {2},
+		2: {3, 4},
+		4: {5},
+	}
+
+	testTable := []struct {
+		name string
+		from int
+		to   int
+		want []int
+	}{
+		{name: "Direct", from: 1, to: 2, want: []int{1, 2}},
+		{name: "Transitive", from: 1, to: 5, want: []int{1, 2, 4, 5}},
+		{name: "Same Node", from: 3, to: 3, want: []int{3}},
+		{name: "Reverse Direction", from: 5, to: 1, want: nil},
+		{name: "Unknown Node", from: 6, to: 1, want: nil},
+		{name: "Sibling", from: 3, to: 4, want: nil},
+	}
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			got := dependencyPath(edges, tt.from, tt.to)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("dependencyPath(%d, %d) = %v, want %v", tt.from, tt.to, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDependencyPath_ExistingCycle(t *testing.T) {
+	// A cycle that is already stored must not make the search loop forever.
+	edges := map[int][]int{
+		1: {2},
+		2: {1},
+	}
+	if got := dependencyPath(edges, 1, 3); got != nil {
+		t.Errorf("dependencyPath(1, 3) = %v, want nil", got)
+	}
+}
+
+func TestFormatDependencyCycle(t *testing.T) {
+	// Making 5 depend on 1 when 1 already reaches 5 closes the cycle 5 -> 1 -> 2 -> 5.
+	got := formatDependencyCycle(5, []int{1, 2, 5})
+	if want := "5 -> 1 -> 2 -> 5"; got != want {
+		t.Errorf("formatDependencyCycle() = %q, want %q", got, want)
+	}
+}
+
+func TestEnsureUnblocked_HiddenBlocker(t *testing.T) {
+	lists := &fakeDependencyLists{
+		lists: map[int]model.TaskList{
+			1: {Id: 1, DependsOn: []int{2, 3}},
+			2: {Id: 2, Status: model.StatusTodo},
+			3: {Id: 3, Status: model.StatusTodo},
+		},
+		visible: map[string][]int{"alice": {1, 2}},
+	}
+
+	err := ensureUnblocked(lists, "alice", 1)
+	if err == nil {
+		t.Fatal("ensureUnblocked() expected an error for open dependencies")
+	}
+	if want := "blocked by open task lists 2 and 1 open task list you cannot access"; !strings.Contains(err.Error(), want) {
+		t.Errorf("ensureUnblocked() error = %q, want it to contain %q", err, want)
+	}
+}
+
+func TestAddDependency_CycleThroughHiddenList(t *testing.T) {
+	// 2 depends on 3, which alice cannot see, and 3 depends on 1.
+	lists := &fakeDependencyLists{
+		lists: map[int]model.TaskList{
+			1: {Id: 1},
+			2: {Id: 2, DependsOn: []int{3}},
+			3: {Id: 3, DependsOn: []int{1}},
+		},
+		visible: map[string][]int{"alice": {1, 2}},
+	}
+	s := NewDependencyService(lists, &fakeWorkspaces{}, discardEvents{})
+
+	err := s.AddDependency("alice", 1, 2)
+	if err == nil {
+		t.Fatal("AddDependency() expected a cycle error")
+	}
+	if want := "1 -> 2 -> ... -> 1"; !strings.Contains(err.Error(), want) {
+		t.Errorf("AddDependency() error = %q, want it to contain %q", err, want)
+	}
+}
+
+func TestDependencyChanges_CreatorWhoLeftTheWorkspace(t *testing.T) {
+	// carol created list 1 in a workspace she has since left, so she can still read it but not change it.
+	lists := &fakeDependencyLists{
+		lists: map[int]model.TaskList{
+			1: {Id: 1, UserId: "carol", WorkspaceId: "ws"},
+			2: {Id: 2, UserId: "carol", DependsOn: []int{}},
+		},
+		visible: map[string][]int{"carol": {1, 2}},
+	}
+	workspaces := &fakeWorkspaces{workspaces: map[string]model.Workspace{
+		"ws": {Members: []model.WorkspaceMember{{UserId: "alice", Role: model.RoleOwner}}},
+	}}
+	s := NewDependencyService(lists, workspaces, discardEvents{})
+
+	if err := s.AddDependency("carol", 1, 2); err == nil {
+		t.Error("AddDependency() expected an error for a former member")
+	}
+	if err := s.RemoveDependency("carol", 1, 2); err == nil {
+		t.Error("RemoveDependency() expected an error for a former member")
+	}
+}
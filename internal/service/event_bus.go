@@ -0,0 +1,105 @@
+package service
+
+import (
+	"TaskManager/internal/domain/model"
+	"sync"
+)
+
+const (
+	eventHistorySize      = 1000
+	subscriptionQueueSize = 64
+)
+
+// EventBus is an in-process publisher that fans task list events out to per-user subscriptions
+// and forwards them to downstream publishers such as webhooks.
+// It keeps a bounded history so subscribers can resume from the last event they received.
+type EventBus struct {
+	mu      sync.Mutex
+	lastId  int64
+	history []model.Event
+	subs    map[*Subscription]struct{}
+	sinks   []EventPublisher
+}
+
+// Subscription receives the events of a single user until it is closed.
+// Its channel is closed when the subscription is closed or falls too far behind.
+type Subscription struct {
+	C      <-chan model.Event
+	c      chan model.Event
+	userId string
+	bus    *EventBus
+}
+
+// NewEventBus initializes a new EventBus that also forwards every event to the provided sinks.
+func NewEventBus(sinks ...EventPublisher) *EventBus {
+	return &EventBus{
+		subs:  make(map[*Subscription]struct{}),
+		sinks: sinks,
+	}
+}
+
+// Publish assigns the event its ID and delivers it to the subscriptions of its user and to the sinks.
+func (b *EventBus) Publish(event model.Event) {
+	b.mu.Lock()
+	b.lastId++
+	event.Id = b.lastId
+
+	b.history = append(b.history, event)
+	if len(b.history) > eventHistorySize {
+		b.history = b.history[len(b.history)-eventHistorySize:]
+	}
+
+	for sub := range b.subs {
+		if sub.userId != event.UserId {
+			continue
+		}
+		select {
+		case sub.c <- event:
+		default:
+			// The subscriber is too slow; drop it so it reconnects and resumes from its last event.
+			b.remove(sub)
+		}
+	}
+	b.mu.Unlock()
+
+	for _, sink := range b.sinks {
+		sink.Publish(event)
+	}
+}
+
+// Subscribe registers a subscription for the user's events. When lastEventId is positive,
+// the retained events of the user published after it are returned so the caller can replay them first.
+func (b *EventBus) Subscribe(userId string, lastEventId int64) ([]model.Event, *Subscription) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var backlog []model.Event
+	if lastEventId > 0 {
+		for _, event := range b.history {
+			if event.Id > lastEventId && event.UserId == userId {
+				backlog = append(backlog, event)
+			}
+		}
+	}
+
+	c := make(chan model.Event, subscriptionQueueSize)
+	sub := &Subscription{C: c, c: c, userId: userId, bus: b}
+	b.subs[sub] = struct{}{}
+	return backlog, sub
+}
+
+// Close unregisters the subscription and closes its channel.
+func (s *Subscription) Close() {
+	s.bus.mu.Lock()
+	defer s.bus.mu.Unlock()
+	s.bus.remove(s)
+}
+
+// remove unregisters a subscription. The caller must hold the lock.
+func (b *EventBus) remove(sub *Subscription) {
+	if _, ok := b.subs[sub]; !ok {
+		return
+	}
+	delete(b.subs, sub)
+	close(sub.c)
+}
@@ -0,0 +1,154 @@
+package service
+
+import (
+	"TaskManager/internal/domain/model"
+	"reflect"
+	"testing"
+)
+
+// recordingPublisher collects the events forwarded to it.
+type recordingPublisher struct {
+	events []model.Event
+}
+
+func (r *recordingPublisher) Publish(event model.Event) {
+	r.events = append(r.events, event)
+}
+
+func TestEventBus_PublishToOwner(t *testing.T) {
+	sink := &recordingPublisher{}
+	bus := NewEventBus(sink)
+
+	_, alice := bus.Subscribe("alice", 0)
+	defer alice.Close()
+	_, bob := bus.Subscribe("bob", 0)
+	defer bob.Close()
+
+	bus.Publish(model.Event{Type: model.EventTaskListCreated, UserId: "alice"})
+
+	select {
+	case event := <-alice.C:
+		if event.Id != 1 || event.Type != model.EventTaskListCreated {
+			t.Errorf("unexpected event: %+v", event)
+		}
+	default:
+		t.Fatal("owner did not receive the event")
+	}
+
+	select {
+	case event := <-bob.C:
+		t.Errorf("other user received event: %+v", event)
+	default:
+	}
+
+	if len(sink.events) != 1 || sink.events[0].Id != 1 {
+		t.Errorf("sink received %+v, want one event with ID 1", sink.events)
+	}
+}
+
+func TestEventBus_SubscribeResumesAfterLastEventId(t *testing.T) {
+	bus := NewEventBus()
+	bus.Publish(model.Event{Type: model.EventTaskListCreated, UserId: "alice"})
+	bus.Publish(model.Event{Type: model.EventTaskListCreated, UserId: "bob"})
+	bus.Publish(model.Event{Type: model.EventTaskListUpdated, UserId: "alice"})
+	bus.Publish(model.Event{Type: model.EventTaskListDeleted, UserId: "alice"})
+
+	testTable := []struct {
+		name        string
+		lastEventId int64
+		expected    []int64
+	}{
+		{
+			name:        "Not Resuming",
+			lastEventId: 0,
+			expected:    nil,
+		},
+		{
+			name:        "Resume After First",
+			lastEventId: 1,
+			expected:    []int64{3, 4},
+		},
+		{
+			name:        "Up To Date",
+			lastEventId: 4,
+			expected:    nil,
+		},
+	}
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			backlog, sub := bus.Subscribe("alice", tt.lastEventId)
+			defer sub.Close()
+
+			var ids []int64
+			for _, event := range backlog {
+				ids = append(ids, event.Id)
+			}
+			if len(ids) != len(tt.expected) {
+				t.Fatalf("backlog IDs = %v, want %v", ids, tt.expected)
+			}
+			for i := range ids {
+				if ids[i] != tt.expected[i] {
+					t.Errorf("backlog IDs = %v, want %v", ids, tt.expected)
+				}
+			}
+		})
+	}
+}
+
+func TestEventBus_DropsSlowSubscriber(t *testing.T) {
+	bus := NewEventBus()
+	_, sub := bus.Subscribe("alice", 0)
+
+	for i := 0; i < subscriptionQueueSize+1; i++ {
+		bus.Publish(model.Event{Type: model.EventTaskListUpdated, UserId: "alice"})
+	}
+
+	received := 0
+	for range sub.C {
+		received++
+	}
+	if received != subscriptionQueueSize {
+		t.Errorf("received %d events before the channel closed, want %d", received, subscriptionQueueSize)
+	}
+	sub.Close()
+}
+
+func TestPublishTaskListEvent_FansOutToWorkspaceMembers(t *testing.T) {
+	workspaces := &fakeWorkspaces{workspaces: map[string]model.Workspace{
+		"ws": {Members: []model.WorkspaceMember{{UserId: "alice", Role: model.RoleOwner}, {UserId: "bob", Role: model.RoleMember}}},
+	}}
+	bus := NewEventBus()
+	_, alice := bus.Subscribe("alice", 0)
+	defer alice.Close()
+	_, carol := bus.Subscribe("carol", 0)
+	defer carol.Close()
+
+	// bob changes a list alice created in their shared workspace.
+	list := model.TaskList{Id: 1, UserId: "alice", WorkspaceId: "ws"}
+	publishTaskListEvent(workspaces, bus, model.EventTaskListUpdated, "bob", list)
+
+	select {
+	case event := <-alice.C:
+		if event.UserId != "alice" || event.TaskList.Id != 1 {
+			t.Errorf("unexpected event: %+v", event)
+		}
+	default:
+		t.Fatal("workspace member did not receive the event")
+	}
+	select {
+	case event := <-carol.C:
+		t.Errorf("user outside the workspace received event: %+v", event)
+	default:
+	}
+
+	// The user who made the change receives it as well, and every user only once.
+	sink := &recordingPublisher{}
+	publishTaskListEvent(workspaces, sink, model.EventTaskListUpdated, "bob", list)
+	var recipients []string
+	for _, event := range sink.events {
+		recipients = append(recipients, event.UserId)
+	}
+	if !reflect.DeepEqual(recipients, []string{"bob", "alice"}) {
+		t.Errorf("event recipients = %v, want [bob alice]", recipients)
+	}
+}
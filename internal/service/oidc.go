@@ -0,0 +1,230 @@
+package service
+
+import (
+	"TaskManager/internal/domain/model"
+	"TaskManager/internal/repository"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"github.com/coreos/go-oidc/v3/oidc"
+	"golang.org/x/oauth2"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// OIDCSettings configures sign-in through an OpenID Connect provider.
+type OIDCSettings struct {
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	// RedirectURL is the callback URL registered with the provider.
+	RedirectURL string
+	Scopes      []string
+	// CreateUsers lets people without a TaskManager account sign in; an account is created for them.
+	// Otherwise only identities that are already linked to an account can sign in.
+	CreateUsers bool
+}
+
+// OIDCLogin carries the values that bind an authorization request to its callback.
+// They are kept by the browser between the two requests and must not be shared with anyone else.
+type OIDCLogin struct {
+	State    string
+	Nonce    string
+	Verifier string
+}
+
+// OIDCService signs users in with the OpenID Connect authorization-code flow.
+type OIDCService struct {
+	repo     repository.Authorization
+	keys     *TokenKeys
+	oauth    oauth2.Config
+	verifier *oidc.IDTokenVerifier
+	create   bool
+}
+
+// oidcClaims are the ID token claims used to find or create the user.
+type oidcClaims struct {
+	Nonce             string `json:"nonce"`
+	Email             string `json:"email"`
+	EmailVerified     bool   `json:"email_verified"`
+	PreferredUsername string `json:"preferred_username"`
+}
+
+// ErrOIDCNotLinked is returned when an identity has no TaskManager account and accounts are not created on sign-in.
+var ErrOIDCNotLinked = errors.New("no account is linked to this identity")
+
+var usernameUnsafe = regexp.MustCompile(`[^a-zA-Z0-9]+`)
+
+// NewOIDCService discovers the provider's endpoints and keys.
+func NewOIDCService(ctx context.Context, settings OIDCSettings, repo repository.Authorization, keys *TokenKeys) (*OIDCService, error) {
+	provider, err := oidc.NewProvider(ctx, settings.Issuer)
+	if err != nil {
+		return nil, fmt.Errorf("error discovering OIDC provider: %w", err)
+	}
+
+	scopes := settings.Scopes
+	if len(scopes) == 0 {
+		scopes = []string{oidc.ScopeOpenID, "profile", "email"}
+	}
+	return &OIDCService{
+		repo: repo,
+		keys: keys,
+		oauth: oauth2.Config{
+			ClientID:     settings.ClientID,
+			ClientSecret: settings.ClientSecret,
+			RedirectURL:  settings.RedirectURL,
+			Endpoint:     provider.Endpoint(),
+			Scopes:       scopes,
+		},
+		verifier: provider.Verifier(&oidc.Config{ClientID: settings.ClientID}),
+		create:   settings.CreateUsers,
+	}, nil
+}
+
+// StartLogin returns the provider URL to send the user to, and the values the callback needs.
+// The flow uses PKCE, and a nonce ties the ID token to this login.
+func (s *OIDCService) StartLogin() (string, OIDCLogin, error) {
+	state, err := randomToken()
+	if err != nil {
+		return "", OIDCLogin{}, err
+	}
+	nonce, err := randomToken()
+	if err != nil {
+		return "", OIDCLogin{}, err
+	}
+	login := OIDCLogin{State: state, Nonce: nonce, Verifier: oauth2.GenerateVerifier()}
+
+	url := s.oauth.AuthCodeURL(state, oidc.Nonce(nonce), oauth2.S256ChallengeOption(login.Verifier))
+	return url, login, nil
+}
+
+// FinishLogin exchanges the authorization code, verifies the ID token and returns a TaskManager token
+// for the linked user.
+func (s *OIDCService) FinishLogin(ctx context.Context, login OIDCLogin, state, code string) (string, error) {
+	if state == "" || state != login.State {
+		return "", errors.New("invalid OIDC state")
+	}
+
+	oauthToken, err := s.oauth.Exchange(ctx, code, oauth2.VerifierOption(login.Verifier))
+	if err != nil {
+		return "", fmt.Errorf("error exchanging authorization code: %w", err)
+	}
+	rawIDToken, ok := oauthToken.Extra("id_token").(string)
+	if !ok {
+		return "", errors.New("token response contains no id_token")
+	}
+	idToken, err := s.verifier.Verify(ctx, rawIDToken)
+	if err != nil {
+		return "", fmt.Errorf("invalid ID token: %w", err)
+	}
+
+	var claims oidcClaims
+	if err := idToken.Claims(&claims); err != nil {
+		return "", fmt.Errorf("error decoding ID token claims: %w", err)
+	}
+	if claims.Nonce != login.Nonce {
+		return "", errors.New("invalid ID token nonce")
+	}
+
+	user, err := s.linkedUser(model.ExternalIdentity{Issuer: idToken.Issuer, Subject: idToken.Subject}, claims)
+	if err != nil {
+		return "", err
+	}
+	if user.Disabled {
+		return "", fmt.Errorf("account is disabled")
+	}
+	return loginToken(s.keys, user)
+}
+
+// linkedUser returns the user linked to the identity, or a new user when CreateUsers is set.
+// Identities are never linked to existing accounts by email, since the provider's claim that an address
+// is verified would then be enough to take over the account that registered it.
+func (s *OIDCService) linkedUser(identity model.ExternalIdentity, claims oidcClaims) (model.User, error) {
+	user, err := s.repo.GetUserByIdentity(identity)
+	if err == nil {
+		return user, nil
+	}
+	if !errors.Is(err, repository.ErrUserNotFound) {
+		return model.User{}, err
+	}
+
+	if !s.create {
+		return model.User{}, ErrOIDCNotLinked
+	}
+	return s.createUser(identity, claims)
+}
+
+// createUser creates an account for the identity. It has a random password, so the user can only
+// sign in through the provider until an administrator resets it.
+func (s *OIDCService) createUser(identity model.ExternalIdentity, claims oidcClaims) (model.User, error) {
+	username, err := s.availableUsername(claims)
+	if err != nil {
+		return model.User{}, err
+	}
+	password, err := randomToken()
+	if err != nil {
+		return model.User{}, err
+	}
+
+	user := model.User{
+		Username:   username,
+		Password:   generatePasswordHash(password),
+		Identities: []model.ExternalIdentity{identity},
+	}
+	// The address stays with the account that already uses it; emails are unique.
+	if claims.EmailVerified && claims.Email != "" {
+		_, err := s.repo.GetUserByEmail(claims.Email)
+		if errors.Is(err, repository.ErrUserNotFound) {
+			user.Email = claims.Email
+		} else if err != nil {
+			return model.User{}, err
+		}
+	}
+	if _, err := s.repo.CreateUser(user); err != nil {
+		return model.User{}, err
+	}
+	return s.repo.GetUserByIdentity(identity)
+}
+
+// availableUsername derives a valid username from the preferred username or the email address,
+// appending a number when it is taken.
+func (s *OIDCService) availableUsername(claims oidcClaims) (string, error) {
+	base := claims.PreferredUsername
+	if base == "" {
+		base, _, _ = strings.Cut(claims.Email, "@")
+	}
+	base = usernameUnsafe.ReplaceAllString(base, "")
+	if len(base) > 24 {
+		base = base[:24]
+	}
+	for len(base) < 3 {
+		base += "user"
+	}
+
+	for i := 1; i <= 100; i++ {
+		username := base
+		if i > 1 {
+			username += strconv.Itoa(i)
+		}
+		_, err := s.repo.GetUserByUsername(username)
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return username, nil
+		}
+		if err != nil {
+			return "", err
+		}
+	}
+	return "", fmt.Errorf("no free username for %q", base)
+}
+
+// randomToken returns 32 random bytes encoded for use in URLs and cookies.
+func randomToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate random token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
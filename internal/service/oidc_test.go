@@ -0,0 +1,151 @@
+package service
+
+import (
+	"TaskManager/internal/domain/model"
+	"TaskManager/internal/repository"
+	"errors"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"testing"
+)
+
+// fakeIdentityUsers keeps users in memory for the OIDC linking tests.
+type fakeIdentityUsers struct {
+	repository.Authorization
+	users []model.User
+}
+
+func (f *fakeIdentityUsers) find(match func(model.User) bool) (model.User, error) {
+	for _, user := range f.users {
+		if match(user) {
+			return user, nil
+		}
+	}
+	return model.User{}, repository.ErrUserNotFound
+}
+
+func (f *fakeIdentityUsers) GetUserByIdentity(identity model.ExternalIdentity) (model.User, error) {
+	return f.find(func(user model.User) bool {
+		for _, linked := range user.Identities {
+			if linked == identity {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+func (f *fakeIdentityUsers) GetUserByEmail(email string) (model.User, error) {
+	return f.find(func(user model.User) bool { return user.Email == email })
+}
+
+func (f *fakeIdentityUsers) GetUserByUsername(username string) (model.User, error) {
+	return f.find(func(user model.User) bool { return user.Username == username })
+}
+
+func (f *fakeIdentityUsers) AddIdentity(id string, identity model.ExternalIdentity) error {
+	for i := range f.users {
+		if f.users[i].Id.Hex() == id {
+			f.users[i].Identities = append(f.users[i].Identities, identity)
+			return nil
+		}
+	}
+	return repository.ErrUserNotFound
+}
+
+func (f *fakeIdentityUsers) CreateUser(user model.User) (int, error) {
+	user.Id = bson.NewObjectID()
+	f.users = append(f.users, user)
+	return len(f.users), nil
+}
+
+func TestOIDCService_LinkedUser(t *testing.T) {
+	identity := model.ExternalIdentity{Issuer: "https://idp.example.com", Subject: "123"}
+	alice := model.User{Id: bson.NewObjectID(), Username: "alice", Email: "alice@example.com"}
+	linked := model.User{Id: bson.NewObjectID(), Username: "linked", Identities: []model.ExternalIdentity{identity}}
+
+	testTable := []struct {
+		name         string
+		users        []model.User
+		claims       oidcClaims
+		createUsers  bool
+		wantUsername string
+		wantEmail    string
+		wantErr      error
+	}{
+		{
+			name:         "Already Linked",
+			users:        []model.User{alice, linked},
+			claims:       oidcClaims{Email: "alice@example.com", EmailVerified: true},
+			wantUsername: "linked",
+		},
+		{
+			name:    "Verified Email Does Not Link",
+			users:   []model.User{alice},
+			claims:  oidcClaims{Email: "alice@example.com", EmailVerified: true},
+			wantErr: ErrOIDCNotLinked,
+		},
+		{
+			name:         "Verified Email In Use Creates Without It",
+			users:        []model.User{alice},
+			claims:       oidcClaims{Email: "alice@example.com", EmailVerified: true},
+			createUsers:  true,
+			wantUsername: "alice2",
+		},
+		{
+			name:         "Verified Email Kept",
+			claims:       oidcClaims{Email: "bob@example.com", EmailVerified: true},
+			createUsers:  true,
+			wantUsername: "bob",
+			wantEmail:    "bob@example.com",
+		},
+		{
+			name:         "Unverified Email Creates",
+			users:        []model.User{alice},
+			claims:       oidcClaims{Email: "alice@example.com"},
+			createUsers:  true,
+			wantUsername: "alice2",
+		},
+		{
+			name:         "Preferred Username Sanitized",
+			claims:       oidcClaims{PreferredUsername: "j.doe-42"},
+			createUsers:  true,
+			wantUsername: "jdoe42",
+		},
+		{
+			name:         "Short Username Padded",
+			claims:       oidcClaims{PreferredUsername: "x"},
+			createUsers:  true,
+			wantUsername: "xuser",
+		},
+		{
+			name:    "Unlinked Without Sign-up",
+			users:   []model.User{alice},
+			claims:  oidcClaims{Email: "alice@example.com"},
+			wantErr: ErrOIDCNotLinked,
+		},
+	}
+
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			users := &fakeIdentityUsers{users: append([]model.User(nil), tt.users...)}
+			s := &OIDCService{repo: users, create: tt.createUsers}
+
+			user, err := s.linkedUser(identity, tt.claims)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("linkedUser() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if user.Username != tt.wantUsername || user.Email != tt.wantEmail {
+				t.Errorf("linkedUser() = %s <%s>, want %s <%s>", user.Username, user.Email, tt.wantUsername, tt.wantEmail)
+			}
+
+			// The identity now resolves to the same user.
+			again, err := users.GetUserByIdentity(identity)
+			if err != nil || again.Id != user.Id {
+				t.Errorf("identity resolves to %v, %v, want %s", again.Username, err, user.Username)
+			}
+		})
+	}
+}
@@ -0,0 +1,200 @@
+package service
+
+import (
+	"TaskManager/internal/domain/model"
+	"TaskManager/internal/repository"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/teambition/rrule-go"
+	"go.uber.org/zap"
+	"sync"
+	"time"
+)
+
+// RecurrenceService manages recurring task list series and materializes their next occurrences.
+type RecurrenceService struct {
+	repo       repository.TaskList
+	workspaces repository.Workspace
+	events     EventPublisher
+	mu         sync.Mutex
+}
+
+// NewRecurrenceService initializes a new RecurrenceService with the provided repositories.
+// Materialized occurrences are published to events.
+func NewRecurrenceService(repo repository.TaskList, workspaces repository.Workspace, events EventPublisher) *RecurrenceService {
+	return &RecurrenceService{
+		repo:       repo,
+		workspaces: workspaces,
+		events:     events,
+	}
+}
+
+// SetRecurrence sets or edits the RRULE of the series the task list belongs to.
+func (s *RecurrenceService) SetRecurrence(userId string, listId int, rule string) error {
+	if err := validateRRule(rule); err != nil {
+		return err
+	}
+
+	head, err := s.seriesHead(userId, listId)
+	if err != nil {
+		return err
+	}
+	if head.DueDate == nil {
+		return errors.New("task list must have a due date to recur")
+	}
+
+	rec := model.Recurrence{
+		RRule:    rule,
+		Start:    *head.DueDate,
+		SeriesId: head.Id,
+	}
+	if head.Recurrence != nil {
+		rec.Start = head.Recurrence.Start
+		rec.SeriesId = head.Recurrence.SeriesId
+	}
+	return s.repo.SetRecurrence(userId, head.Id, &rec)
+}
+
+// StopRecurrence stops the series the task list belongs to, so no further occurrences are created.
+func (s *RecurrenceService) StopRecurrence(userId string, listId int) error {
+	head, err := s.seriesHead(userId, listId)
+	if err != nil {
+		return err
+	}
+	if head.Recurrence == nil {
+		return errors.New("task list is not recurring")
+	}
+	return s.repo.SetRecurrence(userId, head.Id, nil)
+}
+
+// Materialize creates the next occurrence of a recurring task list and returns its ID.
+// It returns 0 when the series has already moved on or has no further occurrences.
+func (s *RecurrenceService) Materialize(list model.TaskList) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Re-read the list so a concurrent completion and scheduler tick create only one occurrence.
+	current, err := s.repo.GetById(list.UserId, list.Id)
+	if err != nil {
+		return 0, err
+	}
+	rec := current.Recurrence
+	if rec == nil || rec.NextId != 0 || current.DueDate == nil {
+		return 0, nil
+	}
+
+	after := *current.DueDate
+	if now := time.Now(); now.After(after) {
+		after = now
+	}
+	next, err := nextOccurrence(*rec, after)
+	if err != nil {
+		return 0, err
+	}
+	if next.IsZero() {
+		return 0, s.repo.SetRecurrence(current.UserId, current.Id, nil)
+	}
+
+	id, err := s.repo.Create(current.UserId, model.TaskList{
+		WorkspaceId:     current.WorkspaceId,
+		Title:           current.Title,
+		Description:     current.Description,
+		Status:          model.StatusTodo,
+		Priority:        current.Priority,
+		DueDate:         &next,
+		ReminderOffsets: current.ReminderOffsets,
+		Tags:            current.Tags,
+		Recurrence: &model.Recurrence{
+			RRule:    rec.RRule,
+			Start:    rec.Start,
+			SeriesId: rec.SeriesId,
+		},
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to create next occurrence: %w", err)
+	}
+
+	rec.NextId = id
+	if err := s.repo.SetRecurrence(current.UserId, current.Id, rec); err != nil {
+		return 0, err
+	}
+
+	if created, err := s.repo.GetById(current.UserId, id); err == nil {
+		publishTaskListEvent(s.workspaces, s.events, model.EventTaskListCreated, current.UserId, created)
+	}
+	return id, nil
+}
+
+// Run materializes the next occurrence of every recurring task list whose due time has arrived,
+// checking every interval until the context is cancelled.
+func (s *RecurrenceService) Run(ctx context.Context, interval time.Duration, logger *zap.Logger) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			lists, err := s.repo.GetDueRecurring(time.Now())
+			if err != nil {
+				logger.Error("Failed to fetch due recurring task lists", zap.Error(err))
+				continue
+			}
+			for _, list := range lists {
+				id, err := s.Materialize(list)
+				if err != nil {
+					logger.Error("Failed to materialize occurrence", zap.Int("task_id", list.Id), zap.Error(err))
+					continue
+				}
+				if id != 0 {
+					logger.Info("Occurrence materialized", zap.Int("task_id", list.Id), zap.Int("next_id", id))
+				}
+			}
+		}
+	}
+}
+
+// seriesHead returns the occurrence of the task list's series that has not been materialized yet,
+// checking that the user may change the series.
+func (s *RecurrenceService) seriesHead(userId string, listId int) (model.TaskList, error) {
+	list, err := s.repo.GetById(userId, listId)
+	if err != nil {
+		return model.TaskList{}, err
+	}
+	if err := authorizeListWrite(s.workspaces, userId, list); err != nil {
+		return model.TaskList{}, err
+	}
+	if list.Recurrence == nil || list.Recurrence.NextId == 0 {
+		return list, nil
+	}
+	return s.repo.GetSeriesHead(userId, list.Recurrence.SeriesId)
+}
+
+// validateRRule checks if the rule is a valid iCalendar RRULE.
+func validateRRule(rule string) error {
+	if rule == "" {
+		return errors.New("recurrence rule cannot be empty")
+	}
+	if _, err := rrule.StrToROption(rule); err != nil {
+		return fmt.Errorf("invalid recurrence rule: %w", err)
+	}
+	return nil
+}
+
+// nextOccurrence returns the first occurrence of the series strictly after the given time,
+// or the zero time when the series has ended.
+func nextOccurrence(rec model.Recurrence, after time.Time) (time.Time, error) {
+	opt, err := rrule.StrToROption(rec.RRule)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid recurrence rule: %w", err)
+	}
+	opt.Dtstart = rec.Start
+
+	rule, err := rrule.NewRRule(*opt)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid recurrence rule: %w", err)
+	}
+	return rule.After(after, false), nil
+}
@@ -0,0 +1,150 @@
+package service
+
+import (
+	"TaskManager/internal/domain/model"
+	"TaskManager/internal/repository"
+	"errors"
+	"testing"
+	"time"
+)
+
+// fakeRecurringLists keeps task lists in memory by ID.
+type fakeRecurringLists struct {
+	repository.TaskList
+	lists map[int]model.TaskList
+}
+
+func (f *fakeRecurringLists) GetById(userId string, listId int) (model.TaskList, error) {
+	list, ok := f.lists[listId]
+	if !ok {
+		return model.TaskList{}, errors.New("task list not found")
+	}
+	return list, nil
+}
+
+func (f *fakeRecurringLists) Create(userId string, list model.TaskList) (int, error) {
+	list.Id = len(f.lists) + 1
+	list.UserId = userId
+	f.lists[list.Id] = list
+	return list.Id, nil
+}
+
+func (f *fakeRecurringLists) SetRecurrence(userId string, listId int, rec *model.Recurrence) error {
+	list := f.lists[listId]
+	list.Recurrence = rec
+	f.lists[listId] = list
+	return nil
+}
+
+type discardEvents struct{}
+
+func (discardEvents) Publish(event model.Event) {}
+
+func TestValidateRRule(t *testing.T) {
+	testTable := []struct {
+		name    string
+		rule    string
+		wantErr bool
+	}{
+		{
+			name:    "Weekly Rule",
+			rule:    "FREQ=WEEKLY;BYDAY=MO",
+			wantErr: false,
+		},
+		{
+			name:    "Daily Rule With Count",
+			rule:    "FREQ=DAILY;COUNT=5",
+			wantErr: false,
+		},
+		{
+			name:    "Empty Rule",
+			rule:    "",
+			wantErr: true,
+		},
+		{
+			name:    "Unknown Frequency",
+			rule:    "FREQ=SOMETIMES",
+			wantErr: true,
+		},
+	}
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateRRule(tt.rule)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateRRule(%s) error = %v, wantErr %v", tt.rule, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNextOccurrence(t *testing.T) {
+	// Monday, 5 January 2026, 09:00 UTC.
+	start := time.Date(2026, time.January, 5, 9, 0, 0, 0, time.UTC)
+
+	testTable := []struct {
+		name     string
+		rule     string
+		after    time.Time
+		expected time.Time
+	}{
+		{
+			name:     "Weekly From Start",
+			rule:     "FREQ=WEEKLY;BYDAY=MO",
+			after:    start,
+			expected: start.AddDate(0, 0, 7),
+		},
+		{
+			name:     "Weekly Skips Missed Occurrences",
+			rule:     "FREQ=WEEKLY;BYDAY=MO",
+			after:    start.AddDate(0, 0, 10),
+			expected: start.AddDate(0, 0, 14),
+		},
+		{
+			name:     "Count Exhausted",
+			rule:     "FREQ=DAILY;COUNT=2",
+			after:    start.AddDate(0, 0, 1),
+			expected: time.Time{},
+		},
+	}
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := model.Recurrence{RRule: tt.rule, Start: start}
+			next, err := nextOccurrence(rec, tt.after)
+			if err != nil {
+				t.Fatalf("nextOccurrence() unexpected error: %v", err)
+			}
+			if !next.Equal(tt.expected) {
+				t.Errorf("nextOccurrence() = %v, expected %v", next, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMaterialize_KeepsWorkspace(t *testing.T) {
+	due := time.Now().Add(-time.Hour)
+	lists := &fakeRecurringLists{lists: map[int]model.TaskList{
+		1: {
+			Id:          1,
+			UserId:      "alice",
+			WorkspaceId: "ws",
+			Title:       "Standup",
+			DueDate:     &due,
+			Recurrence:  &model.Recurrence{RRule: "FREQ=DAILY", Start: due, SeriesId: 1},
+		},
+	}}
+	workspaces := &fakeWorkspaces{workspaces: map[string]model.Workspace{
+		"ws": {Members: []model.WorkspaceMember{{UserId: "alice", Role: model.RoleOwner}}},
+	}}
+	s := NewRecurrenceService(lists, workspaces, discardEvents{})
+
+	id, err := s.Materialize(lists.lists[1])
+	if err != nil {
+		t.Fatalf("Materialize() unexpected error: %v", err)
+	}
+	if next := lists.lists[id]; next.WorkspaceId != "ws" {
+		t.Errorf("next occurrence workspace = %q, expected %q", next.WorkspaceId, "ws")
+	}
+	if lists.lists[1].Recurrence.NextId != id {
+		t.Errorf("series head next id = %d, expected %d", lists.lists[1].Recurrence.NextId, id)
+	}
+}
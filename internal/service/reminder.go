@@ -0,0 +1,161 @@
+package service
+
+import (
+	"TaskManager/internal/domain/model"
+	"TaskManager/internal/notifier"
+	"TaskManager/internal/repository"
+	"context"
+	"errors"
+	"fmt"
+	"go.uber.org/zap"
+	"time"
+)
+
+const (
+	maxReminderOffset   = 7 * 24 * time.Hour
+	maxReminderAttempts = 3
+)
+
+// ReminderService scans for upcoming due dates and notifies task list owners.
+type ReminderService struct {
+	lists     repository.TaskList
+	users     repository.Authorization
+	repo      repository.Reminder
+	notifiers []notifier.Notifier
+}
+
+// NewReminderService initializes a new ReminderService with the provided repositories and notifiers.
+func NewReminderService(lists repository.TaskList, users repository.Authorization, repo repository.Reminder, notifiers []notifier.Notifier) *ReminderService {
+	return &ReminderService{
+		lists:     lists,
+		users:     users,
+		repo:      repo,
+		notifiers: notifiers,
+	}
+}
+
+// Run sends the reminders that became due, checking every interval until the context is cancelled.
+func (s *ReminderService) Run(ctx context.Context, interval time.Duration, logger *zap.Logger) {
+	if len(s.notifiers) == 0 {
+		logger.Info("No notifiers configured, reminders are disabled")
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.sendDue(ctx, time.Now(), interval, logger); err != nil {
+				logger.Error("Failed to send reminders", zap.Error(err))
+			}
+		}
+	}
+}
+
+// sendDue notifies the owners of task lists with a reminder that is due at the given time.
+// Lists that became due during the last interval are included so that zero offsets are not missed.
+func (s *ReminderService) sendDue(ctx context.Context, now time.Time, interval time.Duration, logger *zap.Logger) error {
+	lists, err := s.lists.GetUpcoming(now.Add(-interval), now.Add(maxReminderOffset))
+	if err != nil {
+		return err
+	}
+
+	for _, list := range lists {
+		offsets := dueReminderOffsets(list, now)
+		if len(offsets) == 0 {
+			continue
+		}
+
+		user, err := s.users.GetUserById(list.UserId)
+		if err != nil {
+			logger.Error("Failed to fetch task list owner", zap.Int("task_id", list.Id), zap.Error(err))
+			continue
+		}
+
+		for _, offset := range offsets {
+			for _, n := range s.notifiers {
+				if err := s.deliver(ctx, n, user, list, offset); err != nil {
+					logger.Error("Failed to deliver reminder",
+						zap.Int("task_id", list.Id),
+						zap.String("channel", n.Name()),
+						zap.Error(err),
+					)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// deliver sends one reminder through one notifier unless it was already sent or has exhausted its attempts,
+// and records the outcome.
+func (s *ReminderService) deliver(ctx context.Context, n notifier.Notifier, user model.User, list model.TaskList, offset int) error {
+	delivery, err := s.repo.GetDelivery(list.Id, *list.DueDate, offset, n.Name())
+	if err != nil {
+		return err
+	}
+	if delivery.Status == model.DeliverySent || delivery.Attempts >= maxReminderAttempts {
+		return nil
+	}
+
+	delivery.UserId = list.UserId
+	delivery.ListId = list.Id
+	delivery.DueDate = *list.DueDate
+	delivery.Offset = offset
+	delivery.Channel = n.Name()
+	delivery.Attempts++
+
+	sendErr := n.Notify(ctx, reminderMessage(user, list))
+	if sendErr != nil {
+		delivery.Status = model.DeliveryFailed
+		delivery.Error = sendErr.Error()
+	} else {
+		delivery.Status = model.DeliverySent
+		delivery.Error = ""
+	}
+
+	if err := s.repo.SaveDelivery(delivery); err != nil {
+		return err
+	}
+	return sendErr
+}
+
+// dueReminderOffsets returns the reminder offsets of the task list whose reminder time has arrived.
+func dueReminderOffsets(list model.TaskList, now time.Time) []int {
+	if list.DueDate == nil {
+		return nil
+	}
+
+	var offsets []int
+	for _, offset := range list.ReminderOffsets {
+		remindAt := list.DueDate.Add(-time.Duration(offset) * time.Minute)
+		if !remindAt.After(now) {
+			offsets = append(offsets, offset)
+		}
+	}
+	return offsets
+}
+
+// reminderMessage builds the notification sent to the owner of a task list.
+func reminderMessage(user model.User, list model.TaskList) notifier.Message {
+	return notifier.Message{
+		Recipient: user,
+		List:      list,
+		Subject:   fmt.Sprintf("Reminder: %s", list.Title),
+		Body:      fmt.Sprintf("Your task list %q is due at %s.", list.Title, list.DueDate.Format(time.RFC1123)),
+	}
+}
+
+// validateReminderOffsets checks that reminder offsets are within the supported range.
+func validateReminderOffsets(offsets []int) error {
+	for _, offset := range offsets {
+		if offset < 0 || time.Duration(offset)*time.Minute > maxReminderOffset {
+			return errors.New("reminder offsets must be between 0 and 10080 minutes")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package service
+
+import (
+	"TaskManager/internal/domain/model"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestDueReminderOffsets(t *testing.T) {
+	due := time.Date(2026, time.March, 2, 12, 0, 0, 0, time.UTC)
+
+	testTable := []struct {
+		name     string
+		offsets  []int
+		now      time.Time
+		expected []int
+	}{
+		{
+			name:     "Nothing Due Yet",
+			offsets:  []int{60, 1440},
+			now:      due.Add(-2 * 24 * time.Hour),
+			expected: nil,
+		},
+		{
+			name:     "One Day Before",
+			offsets:  []int{60, 1440},
+			now:      due.Add(-23 * time.Hour),
+			expected: []int{1440},
+		},
+		{
+			name:     "At Due Time",
+			offsets:  []int{0, 60},
+			now:      due,
+			expected: []int{0, 60},
+		},
+	}
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			list := model.TaskList{DueDate: &due, ReminderOffsets: tt.offsets}
+			result := dueReminderOffsets(list, tt.now)
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("dueReminderOffsets() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestValidateReminderOffsets(t *testing.T) {
+	testTable := []struct {
+		name    string
+		offsets []int
+		wantErr bool
+	}{
+		{
+			name:    "Valid Offsets",
+			offsets: []int{0, 15, 10080},
+			wantErr: false,
+		},
+		{
+			name:    "Negative Offset",
+			offsets: []int{-5},
+			wantErr: true,
+		},
+		{
+			name:    "Offset Too Large",
+			offsets: []int{10081},
+			wantErr: true,
+		},
+	}
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateReminderOffsets(tt.offsets)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateReminderOffsets(%v) error = %v, wantErr %v", tt.offsets, err, tt.wantErr)
+			}
+		})
+	}
+}
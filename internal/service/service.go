@@ -1,8 +1,14 @@
 package service
 
 import (
+	"TaskManager/internal/blobstore"
 	"TaskManager/internal/domain/model"
+	"TaskManager/internal/notifier"
 	"TaskManager/internal/repository"
+	"context"
+	"go.uber.org/zap"
+	"io"
+	"time"
 )
 
 // Authorization defines the interface for user authentication and authorization operations.
@@ -10,27 +16,222 @@ type Authorization interface {
 	CreateUser(user model.User) (int, error)
 	GenerateToken(username, password string) (string, error)
 	ParseToken(tokenString string) (string, error)
+	ParseTokenScopes(tokenString string) (string, []string, error)
+	PublicKeys() model.JSONWebKeySet
+	GetUsers(ids []string) ([]model.User, error)
+}
+
+// OIDC defines the interface for signing in through an OpenID Connect provider.
+type OIDC interface {
+	StartLogin() (string, OIDCLogin, error)
+	FinishLogin(ctx context.Context, login OIDCLogin, state, code string) (string, error)
+}
+
+// TwoFactor defines the interface for TOTP two-factor authentication.
+type TwoFactor interface {
+	EnrollTwoFactor(userId string) (model.TwoFactorEnrollment, error)
+	ConfirmTwoFactor(userId, code string) ([]string, error)
+	VerifyTwoFactor(challenge, code string) (string, error)
+	DisableTwoFactor(userId string, input model.DisableTwoFactorInput) error
+}
+
+// StreamTicket defines the interface for the tickets that open event streams.
+type StreamTicket interface {
+	IssueStreamTicket(userId string, scopes []string) (string, time.Time, error)
+	ParseStreamTicket(ticket string) (string, []string, error)
+}
+
+// AccessToken defines the interface for personal access tokens.
+type AccessToken interface {
+	Create(userId string, granted []string, input model.AccessTokenInput) (model.CreatedAccessToken, error)
+	GetAll(userId string) ([]model.AccessToken, error)
+	Revoke(userId string, id string) error
+	Authenticate(value string) (model.AccessToken, error)
 }
 
 // TaskList defines the interface for task list operations.
 type TaskList interface {
 	Create(userId string, list model.TaskList) (int, error)
-	GetAll(userId string) ([]model.TaskList, error)
+	GetAll(userId string, filter model.TaskListFilter) ([]model.TaskList, error)
 	GetById(userId string, listId int) (model.TaskList, error)
+	GetByIds(userId string, listIds []int) ([]model.TaskList, error)
 	Delete(userId string, listId int) error
 	Update(userId string, listId int, input model.UpdateTaskListInput) error
+	AddTags(userId string, listId int, tags []string) error
+	RemoveTag(userId string, listId int, tag string) error
+	GetTags(userId string) ([]model.TagCount, error)
+}
+
+// Recurrence defines the interface for managing recurring task list series.
+type Recurrence interface {
+	SetRecurrence(userId string, listId int, rule string) error
+	StopRecurrence(userId string, listId int) error
+	Materialize(list model.TaskList) (int, error)
+	Run(ctx context.Context, interval time.Duration, logger *zap.Logger)
+}
+
+// Reminder defines the interface for the background worker that sends due-date reminders.
+type Reminder interface {
+	Run(ctx context.Context, interval time.Duration, logger *zap.Logger)
+}
+
+// EventPublisher defines the interface for components notified of task list changes.
+type EventPublisher interface {
+	Publish(event model.Event)
+}
+
+// Events defines the interface for publishing task list events and subscribing to a user's event stream.
+type Events interface {
+	EventPublisher
+	Subscribe(userId string, lastEventId int64) ([]model.Event, *Subscription)
+}
+
+// Webhook defines the interface for webhook subscriptions and the delivery of task list events to them.
+type Webhook interface {
+	EventPublisher
+	Create(userId string, hook model.Webhook) (model.Webhook, error)
+	GetAll(userId string) ([]model.Webhook, error)
+	GetById(userId string, id string) (model.Webhook, error)
+	Delete(userId string, id string) error
+	GetDeliveries(userId string, id string) ([]model.WebhookDelivery, error)
+	Run(ctx context.Context, interval time.Duration, logger *zap.Logger)
+}
+
+// Workspace defines the interface for workspace and membership operations.
+type Workspace interface {
+	Create(userId string, input model.WorkspaceInput) (string, error)
+	GetAll(userId string) ([]model.Workspace, error)
+	GetById(userId string, id string) (model.Workspace, error)
+	Update(userId string, id string, input model.WorkspaceInput) error
+	Delete(userId string, id string) error
+	AddMember(userId string, id string, input model.WorkspaceMemberInput) error
+	UpdateMember(userId string, id string, memberId string, role string) error
+	RemoveMember(userId string, id string, memberId string) error
+}
+
+// Board defines the interface for kanban boards and moving task lists between their columns.
+type Board interface {
+	Create(userId string, input model.BoardInput) (string, error)
+	GetAll(userId string) ([]model.Board, error)
+	GetById(userId string, id string) (model.BoardView, error)
+	Delete(userId string, id string) error
+	AddColumn(userId string, id string, input model.BoardColumnInput) (string, error)
+	RemoveColumn(userId string, id string, columnId string) error
+	Move(userId string, listId int, input model.MoveTaskListInput) error
+}
+
+// Dependency defines the interface for "blocked by" relationships between task lists.
+type Dependency interface {
+	AddDependency(userId string, listId int, dependsOnId int) error
+	RemoveDependency(userId string, listId int, dependsOnId int) error
+	GetDependencies(userId string, listId int) ([]model.TaskList, error)
+	GetDependents(userId string, listId int) ([]model.TaskList, error)
+}
+
+// TaskItem defines the interface for the subtasks of a task list.
+type TaskItem interface {
+	AddItem(userId string, listId int, input model.TaskItemInput) (string, error)
+	UpdateItem(userId string, listId int, itemId string, input model.UpdateTaskItemInput) error
+	DeleteItem(userId string, listId int, itemId string) error
+}
+
+// Comment defines the interface for the discussion of task lists and mention notifications.
+type Comment interface {
+	CreateComment(userId string, listId int, input model.CommentInput) (string, error)
+	GetComments(userId string, listId int, page model.Page) ([]model.CommentThread, int64, error)
+	UpdateComment(userId string, listId int, commentId string, input model.CommentInput) error
+	DeleteComment(userId string, listId int, commentId string) error
+	GetMentions(userId string, page model.Page) ([]model.Mention, int64, error)
+}
+
+// Attachment defines the interface for files attached to task lists.
+type Attachment interface {
+	CreateAttachment(userId string, listId int, filename string, size int64, content io.Reader) (model.Attachment, error)
+	GetAttachments(userId string, listId int) ([]model.Attachment, error)
+	OpenAttachment(userId string, listId int, id string) (model.Attachment, io.ReadCloser, error)
+	DeleteAttachment(userId string, listId int, id string) error
+	DeleteFiles(attachments []model.Attachment) error
+	MaxAttachmentSize() int64
+}
+
+// TimeTracking defines the interface for timers, manual time entries and time reports.
+type TimeTracking interface {
+	StartTimer(userId string, listId int, input model.TimerInput) (string, error)
+	StopTimer(userId string, listId int) (model.TimeEntry, error)
+	AddTimeEntry(userId string, listId int, input model.TimeEntryInput) (string, error)
+	GetTimeEntries(userId string, listId int) ([]model.TimeEntry, error)
+	DeleteTimeEntry(userId string, listId int, id string) error
+	GetTimeReport(userId string, from, to time.Time) (model.TimeReport, error)
+}
+
+// Template defines the interface for task list templates.
+type Template interface {
+	CreateTemplate(userId string, input model.TemplateInput) (string, error)
+	GetTemplates(userId string) ([]model.Template, error)
+	GetTemplate(userId string, id string) (model.Template, error)
+	UpdateTemplate(userId string, id string, input model.TemplateInput) error
+	DeleteTemplate(userId string, id string) error
+	InstantiateTemplate(userId string, id string, input model.InstantiateTemplateInput) (int, error)
+}
+
+// Stats defines the interface for a user's productivity statistics.
+type Stats interface {
+	GetStats(userId string, weeks int) (model.Stats, error)
 }
 
 // Service defines the interface for the service layer, combining authorization and task list operations.
 type Service struct {
 	Authorization
+	// OIDC is nil unless sign-in through an identity provider is configured.
+	OIDC
+	TwoFactor
+	AccessToken
+	StreamTicket
 	TaskList
+	Recurrence
+	Reminder
+	// Webhook and Events both publish events, so they are named rather than embedded
+	// to keep a call to Publish explicit about where the event goes.
+	Webhook Webhook
+	Events  Events
+	Workspace
+	Board
+	Dependency
+	TaskItem
+	Comment
+	Attachment
+	TimeTracking
+	Template
+	Stats
 }
 
-// NewService initializes a new Service instance with the provided repository.
-func NewService(repo *repository.Repository) *Service {
+// NewService initializes a new Service instance with the provided repository, token keys, reminder notifiers,
+// and the blob store and limits for attachments.
+func NewService(repo *repository.Repository, keys *TokenKeys, notifiers []notifier.Notifier, blobs blobstore.BlobStore, limits AttachmentLimits) *Service {
+	webhooks := NewWebhookService(repo.Webhook)
+	events := NewEventBus(webhooks)
+	recurrence := NewRecurrenceService(repo.TaskList, repo.Workspace, events)
+	attachments := NewAttachmentService(repo.Attachment, repo.TaskList, repo.Workspace, blobs, limits)
+	taskLists := NewTaskListService(repo.TaskList, repo.Workspace, recurrence, attachments, events)
+
 	return &Service{
-		Authorization: NewAuthService(repo.Authorization),
-		TaskList:      NewTaskListService(repo.TaskList),
+		Authorization: NewAuthService(repo.Authorization, keys),
+		TwoFactor:     NewTwoFactorService(repo.Authorization, keys),
+		AccessToken:   NewAccessTokenService(repo.AccessToken, repo.Authorization),
+		StreamTicket:  NewStreamTicketService(keys),
+		TaskList:      taskLists,
+		Recurrence:    recurrence,
+		Reminder:      NewReminderService(repo.TaskList, repo.Authorization, repo.Reminder, notifiers),
+		Webhook:       webhooks,
+		Events:        events,
+		Workspace:     NewWorkspaceService(repo.Workspace, repo.Authorization, repo.TaskList),
+		Board:         NewBoardService(repo.Board, repo.TaskList, repo.Workspace, events),
+		Dependency:    NewDependencyService(repo.TaskList, repo.Workspace, events),
+		TaskItem:      NewTaskItemService(repo.TaskList, repo.Workspace, events),
+		Comment:       NewCommentService(repo.Comment, repo.TaskList, repo.Authorization),
+		Attachment:    attachments,
+		TimeTracking:  NewTimeTrackingService(repo.TimeEntry, repo.TaskList),
+		Template:      NewTemplateService(repo.Template, taskLists),
+		Stats:         NewStatsService(repo.TaskList),
 	}
 }
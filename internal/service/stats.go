@@ -0,0 +1,141 @@
+package service
+
+import (
+	"TaskManager/internal/domain/model"
+	"TaskManager/internal/repository"
+	"fmt"
+	"sort"
+	"time"
+)
+
+const (
+	defaultStatsWeeks = 12
+	maxStatsWeeks     = 52
+)
+
+// StatsService computes the productivity statistics of a user.
+type StatsService struct {
+	repo repository.TaskList
+}
+
+// NewStatsService initializes a new StatsService with the provided repository.
+func NewStatsService(repo repository.TaskList) *StatsService {
+	return &StatsService{
+		repo: repo,
+	}
+}
+
+// GetStats returns the statistics of the task lists the user can access, with weekly counts for the given
+// number of ISO weeks up to the current one. The repository aggregates them when it supports it.
+func (s *StatsService) GetStats(userId string, weeks int) (model.Stats, error) {
+	if weeks == 0 {
+		weeks = defaultStatsWeeks
+	}
+	if weeks < 1 || weeks > maxStatsWeeks {
+		return model.Stats{}, fmt.Errorf("weeks must be between 1 and %d", maxStatsWeeks)
+	}
+	now := time.Now()
+	since := isoWeekStart(now).AddDate(0, 0, -7*(weeks-1))
+
+	var stats model.Stats
+	if aggregator, ok := s.repo.(repository.TaskListStats); ok {
+		var err error
+		if stats, err = aggregator.GetStats(userId, since, now); err != nil {
+			return model.Stats{}, err
+		}
+	} else {
+		lists, err := s.repo.GetAll(userId, model.TaskListFilter{})
+		if err != nil {
+			return model.Stats{}, err
+		}
+		stats = computeStats(lists, since, now)
+	}
+	stats.Weekly = fillWeeks(stats.Weekly, since, now)
+	return stats, nil
+}
+
+// computeStats computes from the task lists the same statistics the repository aggregates.
+func computeStats(lists []model.TaskList, since, now time.Time) model.Stats {
+	stats := model.Stats{ByStatus: map[string]int{}, ByPriority: map[string]int{}, Overdue: []model.TaskList{}}
+	weeks := map[string]*model.WeeklyStats{}
+	week := func(t time.Time) *model.WeeklyStats {
+		label := isoWeekLabel(t)
+		if weeks[label] == nil {
+			weeks[label] = &model.WeeklyStats{Week: label}
+		}
+		return weeks[label]
+	}
+
+	var completedCount int
+	var completionTotal time.Duration
+	for _, list := range lists {
+		stats.ByStatus[list.Status]++
+		priority := list.Priority
+		if priority == "" {
+			priority = model.PriorityMedium
+		}
+		stats.ByPriority[priority]++
+
+		done := list.Status == model.StatusDone
+		if !done && list.DueDate != nil && list.DueDate.Before(now) {
+			stats.Overdue = append(stats.Overdue, list)
+		}
+		if list.CreatedAt != nil && !list.CreatedAt.Before(since) {
+			week(*list.CreatedAt).Created++
+		}
+		if done && list.CompletedAt != nil && !list.CompletedAt.Before(since) {
+			week(*list.CompletedAt).Completed++
+		}
+		if done && list.CreatedAt != nil && list.CompletedAt != nil {
+			completedCount++
+			completionTotal += list.CompletedAt.Sub(*list.CreatedAt)
+		}
+	}
+
+	sort.SliceStable(stats.Overdue, func(i, j int) bool {
+		a, b := stats.Overdue[i], stats.Overdue[j]
+		if !a.DueDate.Equal(*b.DueDate) {
+			return a.DueDate.Before(*b.DueDate)
+		}
+		return a.Id < b.Id
+	})
+	for _, w := range weeks {
+		stats.Weekly = append(stats.Weekly, *w)
+	}
+	if completedCount > 0 {
+		seconds := int64((completionTotal / time.Duration(completedCount)).Seconds())
+		stats.AverageCompletionSeconds = &seconds
+	}
+	return stats
+}
+
+// fillWeeks returns the weekly counts of every ISO week from since to now in order, with zeros for weeks without any.
+func fillWeeks(counts []model.WeeklyStats, since, now time.Time) []model.WeeklyStats {
+	byWeek := make(map[string]model.WeeklyStats, len(counts))
+	for _, c := range counts {
+		byWeek[c.Week] = c
+	}
+	var weeks []model.WeeklyStats
+	for start := isoWeekStart(since); !start.After(now); start = start.AddDate(0, 0, 7) {
+		label := isoWeekLabel(start)
+		if c, ok := byWeek[label]; ok {
+			weeks = append(weeks, c)
+		} else {
+			weeks = append(weeks, model.WeeklyStats{Week: label})
+		}
+	}
+	return weeks
+}
+
+// isoWeekStart returns the Monday midnight in UTC that starts the ISO week of t.
+func isoWeekStart(t time.Time) time.Time {
+	t = t.UTC()
+	daysSinceMonday := (int(t.Weekday()) + 6) % 7
+	return time.Date(t.Year(), t.Month(), t.Day()-daysSinceMonday, 0, 0, 0, 0, time.UTC)
+}
+
+// isoWeekLabel formats the ISO week of t in UTC, such as 2024-W05.
+func isoWeekLabel(t time.Time) string {
+	year, week := t.UTC().ISOWeek()
+	return fmt.Sprintf("%04d-W%02d", year, week)
+}
@@ -0,0 +1,108 @@
+package service
+
+import (
+	"TaskManager/internal/domain/model"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestComputeStats(t *testing.T) {
+	at := func(month time.Month, d, hour int) *time.Time {
+		t := time.Date(2024, month, d, hour, 0, 0, 0, time.UTC)
+		return &t
+	}
+	now := *at(5, 15, 12)
+	since := *at(5, 6, 0)
+	lists := []model.TaskList{
+		{Id: 1, Status: model.StatusTodo, Priority: model.PriorityHigh, CreatedAt: at(5, 6, 9), DueDate: at(5, 14, 0)},
+		{Id: 2, Status: model.StatusDone, Priority: model.PriorityLow, CreatedAt: at(5, 7, 9), CompletedAt: at(5, 8, 9), DueDate: at(5, 1, 0)},
+		{Id: 3, Status: model.StatusTodo, CreatedAt: at(5, 13, 9), DueDate: at(5, 10, 0)},
+		{Id: 4, Status: model.StatusTodo, CreatedAt: at(4, 1, 9), DueDate: at(5, 20, 0)},
+		{Id: 5, Status: model.StatusDone, CreatedAt: at(4, 29, 9), CompletedAt: at(5, 14, 9)},
+		// Lists stored before creation times were recorded only count towards completions.
+		{Id: 6, Status: model.StatusDone, Priority: model.PriorityHigh, CompletedAt: at(5, 14, 10)},
+	}
+
+	stats := computeStats(lists, since, now)
+
+	if want := map[string]int{model.StatusTodo: 3, model.StatusDone: 3}; !reflect.DeepEqual(stats.ByStatus, want) {
+		t.Errorf("ByStatus = %v, want %v", stats.ByStatus, want)
+	}
+	want := map[string]int{model.PriorityLow: 1, model.PriorityMedium: 3, model.PriorityHigh: 2}
+	if !reflect.DeepEqual(stats.ByPriority, want) {
+		t.Errorf("ByPriority = %v, want %v", stats.ByPriority, want)
+	}
+
+	var overdue []int
+	for _, list := range stats.Overdue {
+		overdue = append(overdue, list.Id)
+	}
+	if !reflect.DeepEqual(overdue, []int{3, 1}) {
+		t.Errorf("Overdue = %v, want [3 1]", overdue)
+	}
+
+	weekly := fillWeeks(stats.Weekly, since, now)
+	wantWeekly := []model.WeeklyStats{
+		{Week: "2024-W19", Created: 2, Completed: 1},
+		{Week: "2024-W20", Created: 1, Completed: 2},
+	}
+	if !reflect.DeepEqual(weekly, wantWeekly) {
+		t.Errorf("Weekly = %v, want %v", weekly, wantWeekly)
+	}
+
+	// (1 day + 15 days) / 2
+	if stats.AverageCompletionSeconds == nil || *stats.AverageCompletionSeconds != 8*24*60*60 {
+		t.Errorf("AverageCompletionSeconds = %v, want %d", stats.AverageCompletionSeconds, 8*24*60*60)
+	}
+}
+
+func TestComputeStats_Empty(t *testing.T) {
+	now := time.Date(2024, 5, 15, 12, 0, 0, 0, time.UTC)
+
+	stats := computeStats(nil, now.AddDate(0, 0, -7), now)
+
+	if stats.ByStatus == nil || stats.ByPriority == nil || stats.Overdue == nil {
+		t.Error("empty stats groups must encode as empty objects and arrays")
+	}
+	if stats.AverageCompletionSeconds != nil {
+		t.Errorf("AverageCompletionSeconds = %d, want nil", *stats.AverageCompletionSeconds)
+	}
+}
+
+func TestFillWeeks(t *testing.T) {
+	since := time.Date(2024, 12, 16, 0, 0, 0, 0, time.UTC)
+	now := time.Date(2025, 1, 1, 8, 0, 0, 0, time.UTC)
+	counts := []model.WeeklyStats{{Week: "2025-W01", Completed: 2}, {Week: "2024-W51", Created: 1}}
+
+	got := fillWeeks(counts, since, now)
+
+	want := []model.WeeklyStats{
+		{Week: "2024-W51", Created: 1},
+		{Week: "2024-W52"},
+		{Week: "2025-W01", Completed: 2},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("fillWeeks() = %v, want %v", got, want)
+	}
+}
+
+func TestIsoWeekStart(t *testing.T) {
+	testTable := []struct {
+		name string
+		t    time.Time
+		want time.Time
+	}{
+		{name: "Monday", t: time.Date(2024, 5, 13, 0, 0, 0, 0, time.UTC), want: time.Date(2024, 5, 13, 0, 0, 0, 0, time.UTC)},
+		{name: "Sunday", t: time.Date(2024, 5, 19, 23, 59, 0, 0, time.UTC), want: time.Date(2024, 5, 13, 0, 0, 0, 0, time.UTC)},
+		{name: "Across Months", t: time.Date(2024, 10, 2, 12, 0, 0, 0, time.UTC), want: time.Date(2024, 9, 30, 0, 0, 0, 0, time.UTC)},
+		{name: "Other Zone", t: time.Date(2024, 5, 13, 1, 0, 0, 0, time.FixedZone("UTC+3", 3*60*60)), want: time.Date(2024, 5, 6, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isoWeekStart(tt.t); !got.Equal(tt.want) {
+				t.Errorf("isoWeekStart(%v) = %v, want %v", tt.t, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package service
+
+import (
+	"fmt"
+	"github.com/golang-jwt/jwt/v5"
+	"time"
+)
+
+const (
+	// streamTicketTTL is how long a client has to open the event stream with a ticket.
+	streamTicketTTL = 30 * time.Second
+	// streamTicketPurpose marks the tokens that only open event streams.
+	streamTicketPurpose = "stream"
+)
+
+// StreamTicketService issues the short-lived tickets that open event streams. Clients that cannot set
+// headers, such as EventSource and browser WebSockets, pass a ticket in the URL instead of their token,
+// so a URL that ends up in an access log stops being useful within seconds.
+type StreamTicketService struct {
+	keys *TokenKeys
+}
+
+// NewStreamTicketService initializes a new StreamTicketService with the provided token keys.
+func NewStreamTicketService(keys *TokenKeys) *StreamTicketService {
+	return &StreamTicketService{keys: keys}
+}
+
+// IssueStreamTicket returns a ticket for the user that grants the same scopes as the token it was requested with.
+func (s *StreamTicketService) IssueStreamTicket(userId string, scopes []string) (string, time.Time, error) {
+	expiresAt := time.Now().Add(streamTicketTTL)
+	ticket, err := s.keys.Sign(&tokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+		},
+		UserId:  userId,
+		Scopes:  scopes,
+		Purpose: streamTicketPurpose,
+	})
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return ticket, expiresAt, nil
+}
+
+// ParseStreamTicket returns the user and the scopes of a stream ticket.
+func (s *StreamTicketService) ParseStreamTicket(ticket string) (string, []string, error) {
+	parsedToken, err := s.keys.Parse(ticket, &tokenClaims{})
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid or expired stream ticket")
+	}
+	claims, ok := parsedToken.Claims.(*tokenClaims)
+	if !ok || !parsedToken.Valid || claims.Purpose != streamTicketPurpose {
+		return "", nil, fmt.Errorf("invalid or expired stream ticket")
+	}
+	return claims.UserId, claims.Scopes, nil
+}
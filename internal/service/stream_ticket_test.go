@@ -0,0 +1,36 @@
+package service
+
+import (
+	"TaskManager/internal/domain/model"
+	"slices"
+	"testing"
+)
+
+func TestStreamTicket(t *testing.T) {
+	keys, err := NewTokenKeys(TokenSettings{Secret: "secret"})
+	if err != nil {
+		t.Fatalf("NewTokenKeys() error = %v", err)
+	}
+	s := NewStreamTicketService(keys)
+	auth := NewAuthService(&fakeUsers{}, keys)
+
+	ticket, _, err := s.IssueStreamTicket("user-1", []string{model.ScopeTasksRead})
+	if err != nil {
+		t.Fatalf("IssueStreamTicket() error = %v", err)
+	}
+	userId, scopes, err := s.ParseStreamTicket(ticket)
+	if err != nil || userId != "user-1" || !slices.Equal(scopes, []string{model.ScopeTasksRead}) {
+		t.Errorf("ParseStreamTicket() = %s, %v, %v, want user-1 with tasks:read", userId, scopes, err)
+	}
+	if _, err := auth.ParseToken(ticket); err == nil {
+		t.Error("ParseToken() accepted a stream ticket as an access token")
+	}
+
+	token, err := issueToken(keys, model.User{})
+	if err != nil {
+		t.Fatalf("issueToken() error = %v", err)
+	}
+	if _, _, err := s.ParseStreamTicket(token); err == nil {
+		t.Error("ParseStreamTicket() accepted an access token")
+	}
+}
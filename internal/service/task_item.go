@@ -0,0 +1,256 @@
+package service
+
+import (
+	"TaskManager/internal/domain/model"
+	"TaskManager/internal/repository"
+	"errors"
+	"fmt"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"slices"
+	"strings"
+	"sync"
+)
+
+const (
+	maxItemsPerList    = 100
+	maxItemTitleLength = 200
+)
+
+// TaskItemService manages the subtasks of task lists and the dependencies between them.
+type TaskItemService struct {
+	repo       repository.TaskList
+	workspaces repository.Workspace
+	events     EventPublisher
+	// mu serializes the read-modify-write of a list's items.
+	mu sync.Mutex
+}
+
+// NewTaskItemService initializes a new TaskItemService with the provided repositories.
+// Changes to subtasks are published to events.
+func NewTaskItemService(repo repository.TaskList, workspaces repository.Workspace, events EventPublisher) *TaskItemService {
+	return &TaskItemService{
+		repo:       repo,
+		workspaces: workspaces,
+		events:     events,
+	}
+}
+
+// AddItem adds a subtask to a task list and returns its ID.
+func (s *TaskItemService) AddItem(userId string, listId int, input model.TaskItemInput) (string, error) {
+	title, err := validateItemTitle(input.Title)
+	if err != nil {
+		return "", err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	list, err := s.repo.GetById(userId, listId)
+	if err != nil {
+		return "", err
+	}
+	if err := authorizeListWrite(s.workspaces, userId, list); err != nil {
+		return "", err
+	}
+	if len(list.Items) >= maxItemsPerList {
+		return "", fmt.Errorf("a task list can have at most %d items", maxItemsPerList)
+	}
+
+	item := model.TaskItem{Id: bson.NewObjectID().Hex(), Title: title}
+	// Nothing depends on a new item yet, so its dependencies cannot form a cycle.
+	item.DependsOn, err = normalizeItemDependencies(list.Items, item.Id, input.DependsOn)
+	if err != nil {
+		return "", err
+	}
+
+	if err := s.repo.SetItems(userId, listId, append(list.Items, item)); err != nil {
+		return "", err
+	}
+	publishUpdated(s.repo, s.workspaces, s.events, userId, listId)
+	return item.Id, nil
+}
+
+// UpdateItem updates a subtask. It cannot be marked done while items it depends on are open,
+// and its dependencies cannot form a cycle.
+func (s *TaskItemService) UpdateItem(userId string, listId int, itemId string, input model.UpdateTaskItemInput) error {
+	if input.Title == nil && input.Done == nil && input.DependsOn == nil {
+		return errors.New("no fields to update")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	list, err := s.repo.GetById(userId, listId)
+	if err != nil {
+		return err
+	}
+	if err := authorizeListWrite(s.workspaces, userId, list); err != nil {
+		return err
+	}
+	index := slices.IndexFunc(list.Items, func(item model.TaskItem) bool { return item.Id == itemId })
+	if index < 0 {
+		return fmt.Errorf("item %s not found in task list %d", itemId, listId)
+	}
+	item := &list.Items[index]
+
+	if input.Title != nil {
+		if item.Title, err = validateItemTitle(*input.Title); err != nil {
+			return err
+		}
+	}
+	if input.DependsOn != nil {
+		if item.DependsOn, err = normalizeItemDependencies(list.Items, itemId, *input.DependsOn); err != nil {
+			return err
+		}
+		edges := itemDependencyGraph(list.Items)
+		for _, dependsOnId := range item.DependsOn {
+			if path := dependencyPath(edges, dependsOnId, itemId); path != nil {
+				return fmt.Errorf("dependency would create a cycle: %s", formatDependencyCycle(itemId, path))
+			}
+		}
+	}
+	if input.Done != nil {
+		if *input.Done {
+			if open := openItemBlockers(list.Items, *item); len(open) > 0 {
+				return fmt.Errorf("item %s is blocked by open items %s", itemId, strings.Join(open, ", "))
+			}
+		}
+		item.Done = *input.Done
+	}
+
+	if err := s.repo.SetItems(userId, listId, list.Items); err != nil {
+		return err
+	}
+	publishUpdated(s.repo, s.workspaces, s.events, userId, listId)
+	return nil
+}
+
+// DeleteItem removes a subtask and drops it from the dependencies of the other items.
+func (s *TaskItemService) DeleteItem(userId string, listId int, itemId string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	list, err := s.repo.GetById(userId, listId)
+	if err != nil {
+		return err
+	}
+	if err := authorizeListWrite(s.workspaces, userId, list); err != nil {
+		return err
+	}
+	items := make([]model.TaskItem, 0, len(list.Items))
+	for _, item := range list.Items {
+		if item.Id == itemId {
+			continue
+		}
+		item.DependsOn = slices.DeleteFunc(item.DependsOn, func(id string) bool { return id == itemId })
+		items = append(items, item)
+	}
+	if len(items) == len(list.Items) {
+		return fmt.Errorf("item %s not found in task list %d", itemId, listId)
+	}
+
+	if err := s.repo.SetItems(userId, listId, items); err != nil {
+		return err
+	}
+	publishUpdated(s.repo, s.workspaces, s.events, userId, listId)
+	return nil
+}
+
+// newTaskItems validates the subtasks of a new task list and gives them generated IDs.
+// Dependencies refer to the IDs the items were submitted with and are translated to the generated ones.
+// New items always start open.
+func newTaskItems(input []model.TaskItem) ([]model.TaskItem, error) {
+	if len(input) > maxItemsPerList {
+		return nil, fmt.Errorf("a task list can have at most %d items", maxItemsPerList)
+	}
+	items := make([]model.TaskItem, 0, len(input))
+	ids := make(map[string]string, len(input))
+	for _, item := range input {
+		title, err := validateItemTitle(item.Title)
+		if err != nil {
+			return nil, err
+		}
+		id := bson.NewObjectID().Hex()
+		if item.Id != "" {
+			if _, ok := ids[item.Id]; ok {
+				return nil, fmt.Errorf("duplicate item id %s", item.Id)
+			}
+			ids[item.Id] = id
+		}
+		items = append(items, model.TaskItem{Id: id, Title: title})
+	}
+
+	for i, item := range input {
+		dependsOn := make([]string, 0, len(item.DependsOn))
+		for _, dependsOnId := range item.DependsOn {
+			id, ok := ids[dependsOnId]
+			if !ok {
+				return nil, fmt.Errorf("item %s not found in task list", dependsOnId)
+			}
+			dependsOn = append(dependsOn, id)
+		}
+		var err error
+		if items[i].DependsOn, err = normalizeItemDependencies(items, items[i].Id, dependsOn); err != nil {
+			return nil, err
+		}
+	}
+
+	edges := itemDependencyGraph(items)
+	for i, item := range items {
+		for _, dependsOnId := range item.DependsOn {
+			if dependencyPath(edges, dependsOnId, item.Id) != nil {
+				return nil, fmt.Errorf("the dependencies of item %s form a cycle", input[i].Id)
+			}
+		}
+	}
+	return items, nil
+}
+
+// itemDependencyGraph maps each item to the items it depends on.
+func itemDependencyGraph(items []model.TaskItem) map[string][]string {
+	edges := make(map[string][]string, len(items))
+	for _, item := range items {
+		edges[item.Id] = item.DependsOn
+	}
+	return edges
+}
+
+// openItemBlockers returns the IDs of the items the given item depends on that are not done.
+func openItemBlockers(items []model.TaskItem, item model.TaskItem) []string {
+	var open []string
+	for _, other := range items {
+		if !other.Done && slices.Contains(item.DependsOn, other.Id) {
+			open = append(open, other.Id)
+		}
+	}
+	return open
+}
+
+// normalizeItemDependencies drops duplicate dependencies of an item and checks that each names another item of the list.
+func normalizeItemDependencies(items []model.TaskItem, itemId string, dependsOn []string) ([]string, error) {
+	var normalized []string
+	for _, id := range dependsOn {
+		if id == itemId {
+			return nil, errors.New("an item cannot depend on itself")
+		}
+		if !slices.ContainsFunc(items, func(item model.TaskItem) bool { return item.Id == id }) {
+			return nil, fmt.Errorf("item %s not found in task list", id)
+		}
+		if !slices.Contains(normalized, id) {
+			normalized = append(normalized, id)
+		}
+	}
+	return normalized, nil
+}
+
+// validateItemTitle checks the item title and returns it trimmed.
+func validateItemTitle(title string) (string, error) {
+	title = strings.TrimSpace(title)
+	if title == "" {
+		return "", errors.New("item title cannot be empty")
+	}
+	if len(title) > maxItemTitleLength {
+		return "", fmt.Errorf("item title cannot be longer than %d characters", maxItemTitleLength)
+	}
+	return title, nil
+}
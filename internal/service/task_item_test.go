@@ -0,0 +1,137 @@
+package service
+
+import (
+	"TaskManager/internal/domain/model"
+	"reflect"
+	"testing"
+)
+
+func TestNormalizeItemDependencies(t *testing.T) {
+	items := []model.TaskItem{{Id: "a"}, {Id: "b"}, {Id: "c"}}
+
+	testTable := []struct {
+		name      string
+		itemId    string
+		dependsOn []string
+		want      []string
+		wantErr   bool
+	}{
+		{name: "None", itemId: "a", dependsOn: nil, want: nil},
+		{name: "Valid", itemId: "a", dependsOn: []string{"b", "c"}, want: []string{"b", "c"}},
+		{name: "Duplicates", itemId: "a", dependsOn: []string{"b", "b"}, want: []string{"b"}},
+		{name: "Self", itemId: "a", dependsOn: []string{"a"}, wantErr: true},
+		{name: "Unknown Item", itemId: "a", dependsOn: []string{"z"}, wantErr: true},
+	}
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := normalizeItemDependencies(items, tt.itemId, tt.dependsOn)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("normalizeItemDependencies() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("normalizeItemDependencies() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOpenItemBlockers(t *testing.T) {
+	items := []model.TaskItem{
+		{Id: "a", Done: true},
+		{Id: "b"},
+		{Id: "c", DependsOn: []string{"a", "b"}},
+		{Id: "d", DependsOn: []string{"a"}},
+	}
+
+	if got := openItemBlockers(items, items[2]); !reflect.DeepEqual(got, []string{"b"}) {
+		t.Errorf("openItemBlockers(c) = %v, want [b]", got)
+	}
+	if got := openItemBlockers(items, items[3]); got != nil {
+		t.Errorf("openItemBlockers(d) = %v, want nil", got)
+	}
+}
+
+func TestItemDependencyGraph(t *testing.T) {
+	// c depends on b and b on a, so making a depend on c closes a cycle.
+	items := []model.TaskItem{
+		{Id: "a", DependsOn: []string{"c"}},
+		{Id: "b", DependsOn: []string{"a"}},
+		{Id: "c", DependsOn: []string{"b"}},
+	}
+	edges := itemDependencyGraph(items)
+	if path := dependencyPath(edges, "c", "a"); !reflect.DeepEqual(path, []string{"c", "b", "a"}) {
+		t.Errorf("dependencyPath(c, a) = %v, want [c b a]", path)
+	}
+}
+
+func TestValidateItemTitle(t *testing.T) {
+	if title, err := validateItemTitle("  Write tests  "); err != nil || title != "Write tests" {
+		t.Errorf("validateItemTitle() = %q, %v", title, err)
+	}
+	if _, err := validateItemTitle("   "); err == nil {
+		t.Error("validateItemTitle() expected error for a blank title")
+	}
+}
+
+func TestTaskItemChanges_CreatorWhoLeftTheWorkspace(t *testing.T) {
+	// carol created the list in a workspace she has since left, so she can still read it but not change it.
+	lists := &fakeDependencyLists{
+		lists: map[int]model.TaskList{
+			1: {Id: 1, UserId: "carol", WorkspaceId: "ws", Items: []model.TaskItem{{Id: "a", Title: "Item"}}},
+		},
+		visible: map[string][]int{"carol": {1}},
+	}
+	workspaces := &fakeWorkspaces{workspaces: map[string]model.Workspace{
+		"ws": {Members: []model.WorkspaceMember{{UserId: "alice", Role: model.RoleOwner}}},
+	}}
+	s := NewTaskItemService(lists, workspaces, discardEvents{})
+	title := "Renamed"
+
+	if _, err := s.AddItem("carol", 1, model.TaskItemInput{Title: "New"}); err == nil {
+		t.Error("AddItem() expected an error for a former member")
+	}
+	if err := s.UpdateItem("carol", 1, "a", model.UpdateTaskItemInput{Title: &title}); err == nil {
+		t.Error("UpdateItem() expected an error for a former member")
+	}
+	if err := s.DeleteItem("carol", 1, "a"); err == nil {
+		t.Error("DeleteItem() expected an error for a former member")
+	}
+}
+
+func TestNewTaskItems(t *testing.T) {
+	testTable := []struct {
+		name    string
+		input   []model.TaskItem
+		wantErr bool
+	}{
+		{name: "None", input: nil},
+		{name: "Titles Only", input: []model.TaskItem{{Title: "a"}, {Title: "b"}}},
+		{name: "Dependency On Submitted Id", input: []model.TaskItem{{Id: "1", Title: "a"}, {Id: "2", Title: "b", DependsOn: []string{"1"}}}},
+		{name: "Empty Title", input: []model.TaskItem{{Title: " "}}, wantErr: true},
+		{name: "Unknown Dependency", input: []model.TaskItem{{Title: "a", DependsOn: []string{"z"}}}, wantErr: true},
+		{name: "Duplicate Id", input: []model.TaskItem{{Id: "1", Title: "a"}, {Id: "1", Title: "b"}}, wantErr: true},
+		{name: "Cycle", input: []model.TaskItem{{Id: "1", Title: "a", DependsOn: []string{"2"}}, {Id: "2", Title: "b", DependsOn: []string{"1"}}}, wantErr: true},
+	}
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := newTaskItems(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("newTaskItems() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(got) != len(tt.input) {
+				t.Fatalf("newTaskItems() returned %d items, want %d", len(got), len(tt.input))
+			}
+			for i, item := range got {
+				if item.Id == "" || item.Id == tt.input[i].Id || item.Title != tt.input[i].Title {
+					t.Errorf("item %d = %+v, want a generated ID and title %q", i, item, tt.input[i].Title)
+				}
+			}
+			if len(tt.input) == 2 && tt.input[1].DependsOn != nil && !reflect.DeepEqual(got[1].DependsOn, []string{got[0].Id}) {
+				t.Errorf("item 1 depends on %v, want [%s]", got[1].DependsOn, got[0].Id)
+			}
+		})
+	}
+}
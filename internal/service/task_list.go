@@ -4,17 +4,35 @@ import (
 	"TaskManager/internal/domain/model"
 	"TaskManager/internal/repository"
 	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	maxTagsPerList = 20
+	maxTagLength   = 32
 )
 
 // TaskListService provides methods to manage task lists for users.
 type TaskListService struct {
-	repo repository.TaskList
+	repo        repository.TaskList
+	workspaces  repository.Workspace
+	recurrence  Recurrence
+	attachments Attachment
+	events      EventPublisher
 }
 
-// NewTaskListService initializes a new TaskListService with the provided repository.
-func NewTaskListService(repo repository.TaskList) *TaskListService {
+// NewTaskListService initializes a new TaskListService with the provided repositories.
+// Changes to task lists are published to events.
+func NewTaskListService(repo repository.TaskList, workspaces repository.Workspace, recurrence Recurrence, attachments Attachment, events EventPublisher) *TaskListService {
 	return &TaskListService{
-		repo: repo,
+		repo:        repo,
+		workspaces:  workspaces,
+		recurrence:  recurrence,
+		attachments: attachments,
+		events:      events,
 	}
 }
 
@@ -23,12 +41,64 @@ func (s *TaskListService) Create(userId string, list model.TaskList) (int, error
 	if err := validateCreateTaskList(list); err != nil {
 		return 0, err
 	}
-	return s.repo.Create(userId, list)
+	if list.WorkspaceId != "" {
+		if _, err := s.workspaces.GetById(userId, list.WorkspaceId); err != nil {
+			return 0, err
+		}
+	}
+	list.Status = model.StatusTodo
+	list.CompletedAt = nil
+	if list.Priority == "" {
+		list.Priority = model.PriorityMedium
+	}
+	list.BoardId, list.ColumnId, list.Position = "", "", ""
+	list.Tags, _ = normalizeTags(list.Tags)
+	items, err := newTaskItems(list.Items)
+	if err != nil {
+		return 0, err
+	}
+	list.Items = items
+	dependsOn, err := normalizeDependencies(s.repo, userId, list.DependsOn)
+	if err != nil {
+		return 0, err
+	}
+	list.DependsOn = dependsOn
+
+	rec := list.Recurrence
+	list.Recurrence = nil
+	id, err := s.repo.Create(userId, list)
+	if err != nil {
+		return 0, err
+	}
+
+	if rec != nil {
+		err = s.repo.SetRecurrence(userId, id, &model.Recurrence{
+			RRule:    rec.RRule,
+			Start:    *list.DueDate,
+			SeriesId: id,
+		})
+		if err != nil {
+			// The series ID is the list's own ID, so the recurrence can only be set after the insert.
+			// Remove the list again so a retry does not leave a duplicate without a recurrence.
+			if deleteErr := s.repo.Delete(userId, id); deleteErr != nil {
+				return 0, errors.Join(err, deleteErr)
+			}
+			return 0, err
+		}
+	}
+
+	s.publishCurrent(model.EventTaskListCreated, userId, id)
+	return id, nil
 }
 
-// GetAll retrieves all task lists for the specified user.
-func (s *TaskListService) GetAll(userId string) ([]model.TaskList, error) {
-	return s.repo.GetAll(userId)
+// GetAll retrieves all task lists for the specified user matching the filter.
+func (s *TaskListService) GetAll(userId string, filter model.TaskListFilter) ([]model.TaskList, error) {
+	tags, err := normalizeTags(filter.Tags)
+	if err != nil {
+		return nil, err
+	}
+	filter.Tags = tags
+	return s.repo.GetAll(userId, filter)
 }
 
 // GetById retrieves a specific task list by its ID for the specified user.
@@ -36,20 +106,188 @@ func (s *TaskListService) GetById(userId string, listId int) (model.TaskList, er
 	return s.repo.GetById(userId, listId)
 }
 
-// Delete deletes a specific task list by its ID for the specified user.
+// GetByIds retrieves the task lists with the given IDs that the user can access, ordered by ID.
+func (s *TaskListService) GetByIds(userId string, listIds []int) ([]model.TaskList, error) {
+	return s.repo.GetByIds(userId, listIds)
+}
+
+// Delete deletes a specific task list by its ID for the specified user, together with its attachments.
 func (s *TaskListService) Delete(userId string, listId int) error {
 	if err := validateDeleteTaskList(listId); err != nil {
 		return err
 	}
-	return s.repo.Delete(userId, listId)
+
+	list, err := s.repo.GetById(userId, listId)
+	if err != nil {
+		return err
+	}
+	if err := authorizeListWrite(s.workspaces, userId, list); err != nil {
+		return err
+	}
+	// Deleting the list also deletes its attachment records, so collect them first to remove their files afterwards.
+	attachments, err := s.attachments.GetAttachments(userId, listId)
+	if err != nil {
+		return err
+	}
+	if err := s.repo.Delete(userId, listId); err != nil {
+		return err
+	}
+	if err := s.repo.DetachDependency(userId, listId); err != nil {
+		return err
+	}
+	if err := s.attachments.DeleteFiles(attachments); err != nil {
+		return err
+	}
+
+	s.publish(model.EventTaskListDeleted, userId, list)
+	return nil
 }
 
 // Update updates a specific task list by its ID for the specified user.
+// A task list cannot be marked done while task lists it depends on are open.
 func (s *TaskListService) Update(userId string, listId int, input model.UpdateTaskListInput) error {
 	if err := validateUpdateTaskList(input); err != nil {
 		return err
 	}
-	return s.repo.Update(userId, listId, input)
+	current, err := s.repo.GetById(userId, listId)
+	if err != nil {
+		return err
+	}
+	if err := authorizeListWrite(s.workspaces, userId, current); err != nil {
+		return err
+	}
+	if input.WorkspaceId != nil && *input.WorkspaceId != "" {
+		if _, err := s.workspaces.GetById(userId, *input.WorkspaceId); err != nil {
+			return err
+		}
+	}
+	if input.Status != nil && *input.Status == model.StatusDone {
+		if err := ensureUnblocked(s.repo, userId, listId); err != nil {
+			return err
+		}
+	}
+	if err := s.repo.Update(userId, listId, input); err != nil {
+		return err
+	}
+
+	list, err := s.repo.GetById(userId, listId)
+	if err != nil {
+		return err
+	}
+	s.publish(model.EventTaskListUpdated, userId, list)
+
+	if input.Status != nil && *input.Status == model.StatusDone && list.Recurrence != nil {
+		if _, err := s.recurrence.Materialize(list); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AddTags adds tags to a specific task list for the specified user.
+func (s *TaskListService) AddTags(userId string, listId int, tags []string) error {
+	tags, err := normalizeTags(tags)
+	if err != nil {
+		return err
+	}
+	if len(tags) == 0 {
+		return errors.New("no tags to add")
+	}
+
+	list, err := s.repo.GetById(userId, listId)
+	if err != nil {
+		return err
+	}
+	if err := authorizeListWrite(s.workspaces, userId, list); err != nil {
+		return err
+	}
+	if len(mergeTags(list.Tags, tags)) > maxTagsPerList {
+		return fmt.Errorf("a task list can have at most %d tags", maxTagsPerList)
+	}
+
+	if err := s.repo.AddTags(userId, listId, tags); err != nil {
+		return err
+	}
+	s.publishCurrent(model.EventTaskListUpdated, userId, listId)
+	return nil
+}
+
+// RemoveTag removes a tag from a specific task list for the specified user.
+func (s *TaskListService) RemoveTag(userId string, listId int, tag string) error {
+	tags, err := normalizeTags([]string{tag})
+	if err != nil {
+		return err
+	}
+	list, err := s.repo.GetById(userId, listId)
+	if err != nil {
+		return err
+	}
+	if err := authorizeListWrite(s.workspaces, userId, list); err != nil {
+		return err
+	}
+	if err := s.repo.RemoveTag(userId, listId, tags[0]); err != nil {
+		return err
+	}
+	s.publishCurrent(model.EventTaskListUpdated, userId, listId)
+	return nil
+}
+
+// GetTags retrieves the user's tags with their usage counts.
+func (s *TaskListService) GetTags(userId string) ([]model.TagCount, error) {
+	return s.repo.GetTags(userId)
+}
+
+// publish notifies subscribers of a change to a task list.
+func (s *TaskListService) publish(eventType string, userId string, list model.TaskList) {
+	publishTaskListEvent(s.workspaces, s.events, eventType, userId, list)
+}
+
+// publishCurrent notifies subscribers of a change to a task list using its stored state.
+func (s *TaskListService) publishCurrent(eventType string, userId string, listId int) {
+	list, err := s.repo.GetById(userId, listId)
+	if err != nil {
+		list = model.TaskList{Id: listId, UserId: userId}
+	}
+	s.publish(eventType, userId, list)
+}
+
+// publishTaskListEvent publishes a change to a task list made by userId once for every user who can see the list:
+// the user who made the change, the list's creator and, for a list in a workspace, every member of the workspace.
+func publishTaskListEvent(workspaces repository.Workspace, events EventPublisher, eventType string, userId string, list model.TaskList) {
+	recipients := []string{userId}
+	if list.UserId != "" && list.UserId != userId {
+		recipients = append(recipients, list.UserId)
+	}
+	if list.WorkspaceId != "" {
+		// Without the workspace the event still reaches the user who made the change and the creator.
+		if workspace, err := workspaces.GetById(userId, list.WorkspaceId); err == nil {
+			for _, member := range workspace.Members {
+				if !slices.Contains(recipients, member.UserId) {
+					recipients = append(recipients, member.UserId)
+				}
+			}
+		}
+	}
+
+	now := time.Now()
+	for _, recipient := range recipients {
+		events.Publish(model.Event{
+			Type:       eventType,
+			UserId:     recipient,
+			TaskList:   list,
+			OccurredAt: now,
+		})
+	}
+}
+
+// authorizeListWrite checks that the user may change a task list. A list in a workspace can only be changed
+// by a current member, because its creator keeps access to it after leaving the workspace.
+func authorizeListWrite(workspaces repository.Workspace, userId string, list model.TaskList) error {
+	if list.WorkspaceId == "" {
+		return nil
+	}
+	_, err := authorizeWorkspace(workspaces, userId, list.WorkspaceId, model.RoleMember)
+	return err
 }
 
 // validateCreateTaskList checks if the task list is valid for creation.
@@ -57,17 +295,55 @@ func validateCreateTaskList(list model.TaskList) error {
 	if list.Title == "" {
 		return errors.New("task list name cannot be empty")
 	}
+	if list.Priority != "" && !validPriority(list.Priority) {
+		return errors.New("invalid task list priority")
+	}
+	if err := validateReminderOffsets(list.ReminderOffsets); err != nil {
+		return err
+	}
+	tags, err := normalizeTags(list.Tags)
+	if err != nil {
+		return err
+	}
+	if len(tags) > maxTagsPerList {
+		return fmt.Errorf("a task list can have at most %d tags", maxTagsPerList)
+	}
+	if list.Recurrence != nil {
+		if list.DueDate == nil {
+			return errors.New("task list must have a due date to recur")
+		}
+		if err := validateRRule(list.Recurrence.RRule); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
 // validateUpdateTaskList checks if the update input is valid.
 func validateUpdateTaskList(input model.UpdateTaskListInput) error {
-	if input.Title == nil && input.Description == nil {
+	if input.Title == nil && input.Description == nil && input.Status == nil && input.Priority == nil &&
+		input.DueDate == nil && input.ReminderOffsets == nil && input.WorkspaceId == nil {
 		return errors.New("no fields to update")
 	}
+	if input.Status != nil && *input.Status != model.StatusTodo && *input.Status != model.StatusDone {
+		return errors.New("invalid task list status")
+	}
+	if input.Priority != nil && !validPriority(*input.Priority) {
+		return errors.New("invalid task list priority")
+	}
+	if input.ReminderOffsets != nil {
+		if err := validateReminderOffsets(*input.ReminderOffsets); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+// validPriority reports whether the priority is one of the task list priorities.
+func validPriority(priority string) bool {
+	return priority == model.PriorityLow || priority == model.PriorityMedium || priority == model.PriorityHigh
+}
+
 // validateDeleteTaskList checks if the list ID is valid for deletion.
 func validateDeleteTaskList(listId int) error {
 	if listId <= 0 {
@@ -75,3 +351,32 @@ func validateDeleteTaskList(listId int) error {
 	}
 	return nil
 }
+
+// normalizeTags trims and lowercases tags, drops duplicates and checks their length.
+func normalizeTags(tags []string) ([]string, error) {
+	var normalized []string
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" {
+			return nil, errors.New("tag cannot be empty")
+		}
+		if len(tag) > maxTagLength {
+			return nil, fmt.Errorf("tag cannot be longer than %d characters", maxTagLength)
+		}
+		if !slices.Contains(normalized, tag) {
+			normalized = append(normalized, tag)
+		}
+	}
+	return normalized, nil
+}
+
+// mergeTags returns the union of two tag sets.
+func mergeTags(existing, added []string) []string {
+	merged := slices.Clone(existing)
+	for _, tag := range added {
+		if !slices.Contains(merged, tag) {
+			merged = append(merged, tag)
+		}
+	}
+	return merged
+}
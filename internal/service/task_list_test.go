@@ -3,9 +3,25 @@ package service
 import (
 	"TaskManager/internal/domain/model"
 	"errors"
+	"reflect"
 	"testing"
+	"time"
 )
 
+// failingRecurrenceLists stores task lists but cannot set their recurrence.
+type failingRecurrenceLists struct {
+	*fakeRecurringLists
+}
+
+func (f failingRecurrenceLists) SetRecurrence(userId string, listId int, rec *model.Recurrence) error {
+	return errors.New("write failed")
+}
+
+func (f failingRecurrenceLists) Delete(userId string, listId int) error {
+	delete(f.lists, listId)
+	return nil
+}
+
 func TestValidateCreateTaskList(t *testing.T) {
 	testTable := []struct {
 		name     string
@@ -36,6 +52,22 @@ func TestValidateCreateTaskList(t *testing.T) {
 			},
 			expected: nil, // Assuming empty description is allowed
 		},
+		{
+			name: "Valid Priority",
+			list: model.TaskList{
+				Title:    "Urgent Task List",
+				Priority: model.PriorityHigh,
+			},
+			expected: nil,
+		},
+		{
+			name: "Invalid Priority",
+			list: model.TaskList{
+				Title:    "Task List with unknown priority",
+				Priority: "critical",
+			},
+			expected: errors.New("invalid task list priority"),
+		},
 	}
 	for _, tt := range testTable {
 		t.Run(tt.name, func(t *testing.T) {
@@ -79,3 +111,58 @@ func TestValidateDeleteTaskList(t *testing.T) {
 		})
 	}
 }
+
+func TestNormalizeTags(t *testing.T) {
+	testTable := []struct {
+		name     string
+		tags     []string
+		expected []string
+		wantErr  bool
+	}{
+		{
+			name:     "Trims And Lowercases",
+			tags:     []string{" Work ", "URGENT"},
+			expected: []string{"work", "urgent"},
+		},
+		{
+			name:     "Drops Duplicates",
+			tags:     []string{"work", "Work", "home"},
+			expected: []string{"work", "home"},
+		},
+		{
+			name:    "Empty Tag",
+			tags:    []string{"work", "  "},
+			wantErr: true,
+		},
+		{
+			name:    "Tag Too Long",
+			tags:    []string{"this-tag-is-definitely-longer-than-allowed"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := normalizeTags(tt.tags)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("normalizeTags(%v) error = %v, wantErr %v", tt.tags, err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("normalizeTags(%v) = %v, expected %v", tt.tags, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCreate_RemovesListWhenRecurrenceFails(t *testing.T) {
+	lists := failingRecurrenceLists{&fakeRecurringLists{lists: map[int]model.TaskList{}}}
+	s := NewTaskListService(lists, nil, nil, nil, discardEvents{})
+	due := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+
+	_, err := s.Create("alice", model.TaskList{Title: "Standup", DueDate: &due, Recurrence: &model.Recurrence{RRule: "FREQ=DAILY"}})
+	if err == nil {
+		t.Fatal("Create() expected an error")
+	}
+	if len(lists.lists) != 0 {
+		t.Errorf("Create() left %d task lists behind, want none", len(lists.lists))
+	}
+}
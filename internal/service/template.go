@@ -0,0 +1,192 @@
+package service
+
+import (
+	"TaskManager/internal/domain/model"
+	"TaskManager/internal/repository"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const maxTemplateNameLength = 100
+
+// templateVariablePattern matches a {{name}} placeholder, allowing spaces inside the braces.
+var templateVariablePattern = regexp.MustCompile(`\{\{\s*([A-Za-z0-9_]+)\s*\}\}`)
+
+// TemplateService manages task list templates and creates task lists from them.
+type TemplateService struct {
+	repo  repository.Template
+	lists TaskList
+}
+
+// NewTemplateService initializes a new TemplateService. Lists are instantiated through the TaskList service,
+// so they are validated and published like any other new list.
+func NewTemplateService(repo repository.Template, lists TaskList) *TemplateService {
+	return &TemplateService{
+		repo:  repo,
+		lists: lists,
+	}
+}
+
+// CreateTemplate saves a new template for the user and returns its ID.
+func (s *TemplateService) CreateTemplate(userId string, input model.TemplateInput) (string, error) {
+	template, err := newTemplate(input)
+	if err != nil {
+		return "", err
+	}
+	template.UserId = userId
+	template.CreatedAt = template.UpdatedAt
+	return s.repo.Create(template)
+}
+
+// GetTemplates retrieves the user's templates.
+func (s *TemplateService) GetTemplates(userId string) ([]model.Template, error) {
+	return s.repo.GetAll(userId)
+}
+
+// GetTemplate retrieves a template of the user.
+func (s *TemplateService) GetTemplate(userId string, id string) (model.Template, error) {
+	return s.repo.GetById(userId, id)
+}
+
+// UpdateTemplate replaces the content of a template of the user.
+func (s *TemplateService) UpdateTemplate(userId string, id string, input model.TemplateInput) error {
+	template, err := newTemplate(input)
+	if err != nil {
+		return err
+	}
+	return s.repo.Update(userId, id, template)
+}
+
+// DeleteTemplate removes a template of the user.
+func (s *TemplateService) DeleteTemplate(userId string, id string) error {
+	return s.repo.Delete(userId, id)
+}
+
+// InstantiateTemplate creates a task list for the user from a template, substituting its variables, and returns the list ID.
+func (s *TemplateService) InstantiateTemplate(userId string, id string, input model.InstantiateTemplateInput) (int, error) {
+	template, err := s.repo.GetById(userId, id)
+	if err != nil {
+		return 0, err
+	}
+
+	list, err := renderTemplate(template, templateVariables(time.Now(), input.Variables))
+	if err != nil {
+		return 0, err
+	}
+	list.DueDate = input.DueDate
+	list.WorkspaceId = input.WorkspaceId
+
+	// The subtasks are stored with the list, so a failure never leaves a partly instantiated list behind.
+	return s.lists.Create(userId, list)
+}
+
+// renderTemplate builds the task list described by a template with its variables substituted.
+func renderTemplate(template model.Template, variables map[string]string) (model.TaskList, error) {
+	title, err := substituteVariables(template.Title, variables)
+	if err != nil {
+		return model.TaskList{}, err
+	}
+	description, err := substituteVariables(template.Description, variables)
+	if err != nil {
+		return model.TaskList{}, err
+	}
+
+	list := model.TaskList{
+		Title:       title,
+		Description: description,
+		Tags:        template.Tags,
+	}
+	for _, itemTitle := range template.Items {
+		itemTitle, err := substituteVariables(itemTitle, variables)
+		if err != nil {
+			return model.TaskList{}, err
+		}
+		if itemTitle, err = validateItemTitle(itemTitle); err != nil {
+			return model.TaskList{}, fmt.Errorf("invalid template item: %w", err)
+		}
+		list.Items = append(list.Items, model.TaskItem{Title: itemTitle})
+	}
+	return list, nil
+}
+
+// templateVariables returns the built-in variables for the given time, overridden and extended by custom ones.
+// The built-ins are date (2006-01-02), year, month (01-12) and week (ISO week number), all in UTC.
+func templateVariables(now time.Time, custom map[string]string) map[string]string {
+	now = now.UTC()
+	_, week := now.ISOWeek()
+	variables := map[string]string{
+		"date":  now.Format("2006-01-02"),
+		"year":  strconv.Itoa(now.Year()),
+		"month": now.Format("01"),
+		"week":  strconv.Itoa(week),
+	}
+	for name, value := range custom {
+		variables[name] = value
+	}
+	return variables
+}
+
+// substituteVariables replaces each {{name}} placeholder with the value of its variable.
+// Unknown variables are reported rather than left in the text.
+func substituteVariables(text string, variables map[string]string) (string, error) {
+	var unknown []string
+	result := templateVariablePattern.ReplaceAllStringFunc(text, func(placeholder string) string {
+		name := templateVariablePattern.FindStringSubmatch(placeholder)[1]
+		value, ok := variables[name]
+		if !ok {
+			unknown = append(unknown, name)
+			return placeholder
+		}
+		return value
+	})
+	if len(unknown) > 0 {
+		return "", fmt.Errorf("unknown template variables: %s", strings.Join(unknown, ", "))
+	}
+	return result, nil
+}
+
+// newTemplate validates the template input and returns the template it describes.
+func newTemplate(input model.TemplateInput) (model.Template, error) {
+	name := strings.TrimSpace(input.Name)
+	if name == "" {
+		return model.Template{}, errors.New("template name cannot be empty")
+	}
+	if len(name) > maxTemplateNameLength {
+		return model.Template{}, fmt.Errorf("template name cannot be longer than %d characters", maxTemplateNameLength)
+	}
+	if strings.TrimSpace(input.Title) == "" {
+		return model.Template{}, errors.New("template title cannot be empty")
+	}
+
+	tags, err := normalizeTags(input.Tags)
+	if err != nil {
+		return model.Template{}, err
+	}
+	if len(tags) > maxTagsPerList {
+		return model.Template{}, fmt.Errorf("a task list can have at most %d tags", maxTagsPerList)
+	}
+	if len(input.Items) > maxItemsPerList {
+		return model.Template{}, fmt.Errorf("a task list can have at most %d items", maxItemsPerList)
+	}
+	var items []string
+	for _, item := range input.Items {
+		title, err := validateItemTitle(item)
+		if err != nil {
+			return model.Template{}, err
+		}
+		items = append(items, title)
+	}
+
+	return model.Template{
+		Name:        name,
+		Title:       strings.TrimSpace(input.Title),
+		Description: input.Description,
+		Tags:        tags,
+		Items:       items,
+		UpdatedAt:   time.Now(),
+	}, nil
+}
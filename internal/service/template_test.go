@@ -0,0 +1,155 @@
+package service
+
+import (
+	"TaskManager/internal/domain/model"
+	"TaskManager/internal/repository"
+	"strings"
+	"testing"
+	"time"
+)
+
+type fakeTemplates struct {
+	repository.Template
+	template model.Template
+}
+
+func (f *fakeTemplates) GetById(userId string, id string) (model.Template, error) {
+	return f.template, nil
+}
+
+// fakeTemplateLists records the lists created from a template.
+type fakeTemplateLists struct {
+	TaskList
+	created []model.TaskList
+}
+
+func (f *fakeTemplateLists) Create(userId string, list model.TaskList) (int, error) {
+	f.created = append(f.created, list)
+	return 7, nil
+}
+
+func TestSubstituteVariables(t *testing.T) {
+	variables := map[string]string{"date": "2024-05-06", "version": "1.4"}
+
+	testTable := []struct {
+		name    string
+		text    string
+		want    string
+		wantErr bool
+	}{
+		{name: "No Placeholders", text: "Onboarding", want: "Onboarding"},
+		{name: "Single", text: "Standup {{date}}", want: "Standup 2024-05-06"},
+		{name: "Spaces Inside Braces", text: "Release {{ version }}", want: "Release 1.4"},
+		{name: "Repeated", text: "{{date}}/{{date}}", want: "2024-05-06/2024-05-06"},
+		{name: "Unknown Variable", text: "Hello {{name}}", wantErr: true},
+		{name: "Single Braces Untouched", text: "{date}", want: "{date}"},
+	}
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := substituteVariables(tt.text, variables)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("substituteVariables(%q) error = %v, wantErr %v", tt.text, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("substituteVariables(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTemplateVariables(t *testing.T) {
+	now := time.Date(2024, 12, 30, 23, 0, 0, 0, time.FixedZone("UTC-2", -2*60*60))
+
+	variables := templateVariables(now, map[string]string{"year": "custom", "team": "core"})
+
+	// 2024-12-30 23:00 at UTC-2 is 2024-12-31 in UTC, which belongs to ISO week 1 of 2025.
+	want := map[string]string{"date": "2024-12-31", "year": "custom", "month": "12", "week": "1", "team": "core"}
+	for name, value := range want {
+		if variables[name] != value {
+			t.Errorf("variables[%q] = %q, want %q", name, variables[name], value)
+		}
+	}
+}
+
+func TestRenderTemplate(t *testing.T) {
+	template := model.Template{
+		Title:       "Release {{version}}",
+		Description: "Cut on {{date}}",
+		Tags:        []string{"release"},
+		Items:       []string{"Tag {{version}}", "Publish notes"},
+	}
+
+	list, err := renderTemplate(template, map[string]string{"version": "2.0", "date": "2024-05-06"})
+	if err != nil {
+		t.Fatalf("renderTemplate() error = %v", err)
+	}
+	if list.Title != "Release 2.0" || list.Description != "Cut on 2024-05-06" {
+		t.Errorf("renderTemplate() title = %q, description = %q", list.Title, list.Description)
+	}
+	if len(list.Items) != 2 || list.Items[0].Title != "Tag 2.0" || list.Items[1].Title != "Publish notes" {
+		t.Errorf("renderTemplate() items = %v", list.Items)
+	}
+	if len(list.Tags) != 1 || list.Tags[0] != "release" {
+		t.Errorf("renderTemplate() tags = %v", list.Tags)
+	}
+
+	if _, err := renderTemplate(template, map[string]string{"date": "2024-05-06"}); err == nil {
+		t.Error("renderTemplate() expected error for a missing variable")
+	}
+}
+
+func TestNewTemplate(t *testing.T) {
+	testTable := []struct {
+		name    string
+		input   model.TemplateInput
+		wantErr bool
+	}{
+		{name: "Valid", input: model.TemplateInput{Name: "Onboarding", Title: "Onboard {{name}}", Items: []string{"Laptop"}}},
+		{name: "Missing Name", input: model.TemplateInput{Title: "Onboard"}, wantErr: true},
+		{name: "Missing Title", input: model.TemplateInput{Name: "Onboarding"}, wantErr: true},
+		{name: "Empty Item", input: model.TemplateInput{Name: "Onboarding", Title: "Onboard", Items: []string{" "}}, wantErr: true},
+		{name: "Empty Tag", input: model.TemplateInput{Name: "Onboarding", Title: "Onboard", Tags: []string{""}}, wantErr: true},
+	}
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := newTemplate(tt.input); (err != nil) != tt.wantErr {
+				t.Errorf("newTemplate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestInstantiateTemplate_CreatesItemsWithList(t *testing.T) {
+	templates := &fakeTemplates{template: model.Template{Title: "Release {{version}}", Items: []string{"Tag {{version}}", "Publish notes"}}}
+	lists := &fakeTemplateLists{}
+	s := NewTemplateService(templates, lists)
+
+	id, err := s.InstantiateTemplate("alice", "template", model.InstantiateTemplateInput{Variables: map[string]string{"version": "2.0"}})
+	if err != nil {
+		t.Fatalf("InstantiateTemplate() unexpected error: %v", err)
+	}
+	if id != 7 {
+		t.Errorf("InstantiateTemplate() = %d, want 7", id)
+	}
+	if len(lists.created) != 1 {
+		t.Fatalf("created %d lists, want 1", len(lists.created))
+	}
+	items := lists.created[0].Items
+	if len(items) != 2 || items[0].Title != "Tag 2.0" || items[1].Title != "Publish notes" {
+		t.Errorf("created list items = %v", items)
+	}
+}
+
+func TestInstantiateTemplate_ItemTooLongAfterSubstitution(t *testing.T) {
+	templates := &fakeTemplates{template: model.Template{Title: "Release", Items: []string{"Tag {{version}}"}}}
+	lists := &fakeTemplateLists{}
+	s := NewTemplateService(templates, lists)
+
+	version := strings.Repeat("9", maxItemTitleLength)
+	if _, err := s.InstantiateTemplate("alice", "template", model.InstantiateTemplateInput{Variables: map[string]string{"version": version}}); err == nil {
+		t.Fatal("InstantiateTemplate() expected an error")
+	}
+	if len(lists.created) != 0 {
+		t.Errorf("created %d lists, want none", len(lists.created))
+	}
+}
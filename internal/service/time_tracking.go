@@ -0,0 +1,215 @@
+package service
+
+import (
+	"TaskManager/internal/domain/model"
+	"TaskManager/internal/repository"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	maxTimeEntryDuration = 24 * time.Hour
+	maxTimeEntryNote     = 500
+	maxTimeReportRange   = 366 * 24 * time.Hour
+	timeReportDayFormat  = "2006-01-02"
+)
+
+// TimeTrackingService tracks the time users spend on task lists and reports on it.
+type TimeTrackingService struct {
+	repo  repository.TimeEntry
+	lists repository.TaskList
+}
+
+// NewTimeTrackingService initializes a new TimeTrackingService with the provided repositories.
+func NewTimeTrackingService(repo repository.TimeEntry, lists repository.TaskList) *TimeTrackingService {
+	return &TimeTrackingService{
+		repo:  repo,
+		lists: lists,
+	}
+}
+
+// StartTimer starts a timer on a task list the user can access and returns its ID.
+// A user can have only one running timer.
+func (s *TimeTrackingService) StartTimer(userId string, listId int, input model.TimerInput) (string, error) {
+	note, err := validateTimeEntryNote(input.Note)
+	if err != nil {
+		return "", err
+	}
+	if _, err := s.lists.GetById(userId, listId); err != nil {
+		return "", err
+	}
+	if running, err := s.repo.GetRunning(userId); err == nil {
+		return "", fmt.Errorf("a timer is already running on task list %d", running.ListId)
+	}
+
+	now := time.Now()
+	return s.repo.Create(model.TimeEntry{
+		UserId:    userId,
+		ListId:    listId,
+		Start:     now,
+		Running:   true,
+		Note:      note,
+		CreatedAt: now,
+	})
+}
+
+// StopTimer stops the user's running timer on a task list and returns the finished entry.
+func (s *TimeTrackingService) StopTimer(userId string, listId int) (model.TimeEntry, error) {
+	entry, err := s.repo.GetRunning(userId)
+	if err != nil {
+		return model.TimeEntry{}, err
+	}
+	if entry.ListId != listId {
+		return model.TimeEntry{}, fmt.Errorf("the running timer is on task list %d", entry.ListId)
+	}
+
+	end := time.Now()
+	seconds := int64(end.Sub(entry.Start).Seconds())
+	if err := s.repo.Stop(entry.Id.Hex(), end, seconds); err != nil {
+		return model.TimeEntry{}, err
+	}
+	entry.End, entry.Seconds, entry.Running = &end, seconds, false
+	return entry, nil
+}
+
+// AddTimeEntry records time spent on a task list by hand and returns the entry ID.
+func (s *TimeTrackingService) AddTimeEntry(userId string, listId int, input model.TimeEntryInput) (string, error) {
+	if err := validateTimeEntry(input, time.Now()); err != nil {
+		return "", err
+	}
+	note, err := validateTimeEntryNote(input.Note)
+	if err != nil {
+		return "", err
+	}
+	if _, err := s.lists.GetById(userId, listId); err != nil {
+		return "", err
+	}
+
+	end := input.End
+	return s.repo.Create(model.TimeEntry{
+		UserId:    userId,
+		ListId:    listId,
+		Start:     input.Start,
+		End:       &end,
+		Seconds:   int64(input.End.Sub(input.Start).Seconds()),
+		Note:      note,
+		CreatedAt: time.Now(),
+	})
+}
+
+// GetTimeEntries retrieves the time tracked on a task list the user can access, newest first.
+func (s *TimeTrackingService) GetTimeEntries(userId string, listId int) ([]model.TimeEntry, error) {
+	if _, err := s.lists.GetById(userId, listId); err != nil {
+		return nil, err
+	}
+	return s.repo.GetByList(listId)
+}
+
+// DeleteTimeEntry removes one of the user's time entries from a task list.
+func (s *TimeTrackingService) DeleteTimeEntry(userId string, listId int, id string) error {
+	return s.repo.Delete(userId, listId, id)
+}
+
+// GetTimeReport aggregates the user's finished time entries that started within [from, to) by list, tag and UTC day.
+// Entries keep counting after their list is deleted or becomes inaccessible, but without a title or tags.
+func (s *TimeTrackingService) GetTimeReport(userId string, from, to time.Time) (model.TimeReport, error) {
+	if !to.After(from) {
+		return model.TimeReport{}, errors.New("report range must end after it starts")
+	}
+	if to.Sub(from) > maxTimeReportRange {
+		return model.TimeReport{}, fmt.Errorf("report range cannot be longer than %d days", int(maxTimeReportRange.Hours()/24))
+	}
+
+	entries, err := s.repo.GetInRange(userId, from, to)
+	if err != nil {
+		return model.TimeReport{}, err
+	}
+
+	var listIds []int
+	for _, entry := range entries {
+		listIds = append(listIds, entry.ListId)
+	}
+	lists, err := s.lists.GetByIds(userId, listIds)
+	if err != nil {
+		return model.TimeReport{}, err
+	}
+
+	report := buildTimeReport(entries, lists)
+	report.From, report.To = from, to
+	return report, nil
+}
+
+// buildTimeReport sums the entries per list, tag and UTC day of their start.
+// Lists are ordered by most time tracked, tags likewise, and days chronologically.
+func buildTimeReport(entries []model.TimeEntry, lists []model.TaskList) model.TimeReport {
+	byId := make(map[int]model.TaskList, len(lists))
+	for _, list := range lists {
+		byId[list.Id] = list
+	}
+
+	perList, perTag, perDay := map[string]int64{}, map[string]int64{}, map[string]int64{}
+	labels := map[string]string{}
+	var report model.TimeReport
+	for _, entry := range entries {
+		report.TotalSeconds += entry.Seconds
+
+		key := strconv.Itoa(entry.ListId)
+		perList[key] += entry.Seconds
+		list := byId[entry.ListId]
+		labels[key] = list.Title
+		for _, tag := range list.Tags {
+			perTag[tag] += entry.Seconds
+		}
+		perDay[entry.Start.UTC().Format(timeReportDayFormat)] += entry.Seconds
+	}
+
+	report.ByList = sortedTimeTotals(perList, labels, false)
+	report.ByTag = sortedTimeTotals(perTag, nil, false)
+	report.ByDay = sortedTimeTotals(perDay, nil, true)
+	return report
+}
+
+// sortedTimeTotals turns sums into report rows, ordered by key or by most time with ties broken by key.
+func sortedTimeTotals(sums map[string]int64, labels map[string]string, byKey bool) []model.TimeTotal {
+	totals := make([]model.TimeTotal, 0, len(sums))
+	for key, seconds := range sums {
+		totals = append(totals, model.TimeTotal{Key: key, Label: labels[key], Seconds: seconds})
+	}
+	sort.Slice(totals, func(i, j int) bool {
+		if !byKey && totals[i].Seconds != totals[j].Seconds {
+			return totals[i].Seconds > totals[j].Seconds
+		}
+		return totals[i].Key < totals[j].Key
+	})
+	return totals
+}
+
+// validateTimeEntry checks that a manual entry ends after it starts, is not too long and is not in the future.
+func validateTimeEntry(input model.TimeEntryInput, now time.Time) error {
+	if input.Start.IsZero() || input.End.IsZero() {
+		return errors.New("time entry must have a start and an end")
+	}
+	if !input.End.After(input.Start) {
+		return errors.New("time entry must end after it starts")
+	}
+	if input.End.Sub(input.Start) > maxTimeEntryDuration {
+		return fmt.Errorf("time entry cannot be longer than %s", maxTimeEntryDuration)
+	}
+	if input.End.After(now) {
+		return errors.New("time entry cannot end in the future")
+	}
+	return nil
+}
+
+// validateTimeEntryNote checks the note of a time entry and returns it trimmed.
+func validateTimeEntryNote(note string) (string, error) {
+	note = strings.TrimSpace(note)
+	if len(note) > maxTimeEntryNote {
+		return "", fmt.Errorf("time entry note cannot be longer than %d characters", maxTimeEntryNote)
+	}
+	return note, nil
+}
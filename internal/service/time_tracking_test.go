@@ -0,0 +1,85 @@
+package service
+
+import (
+	"TaskManager/internal/domain/model"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestBuildTimeReport(t *testing.T) {
+	day := func(d, hour int) time.Time { return time.Date(2024, 5, d, hour, 0, 0, 0, time.UTC) }
+	entries := []model.TimeEntry{
+		{ListId: 1, Start: day(1, 9), Seconds: 3600},
+		{ListId: 2, Start: day(1, 23), Seconds: 1800},
+		{ListId: 1, Start: day(2, 10), Seconds: 600},
+		{ListId: 3, Start: day(3, 8), Seconds: 1800},
+	}
+	lists := []model.TaskList{
+		{Id: 1, Title: "Website", Tags: []string{"client-a", "dev"}},
+		{Id: 2, Title: "Invoices", Tags: []string{"client-a"}},
+	}
+
+	report := buildTimeReport(entries, lists)
+
+	if report.TotalSeconds != 7800 {
+		t.Errorf("TotalSeconds = %d, want 7800", report.TotalSeconds)
+	}
+	wantByList := []model.TimeTotal{
+		{Key: "1", Label: "Website", Seconds: 4200},
+		{Key: "2", Label: "Invoices", Seconds: 1800},
+		{Key: "3", Seconds: 1800},
+	}
+	if !reflect.DeepEqual(report.ByList, wantByList) {
+		t.Errorf("ByList = %v, want %v", report.ByList, wantByList)
+	}
+	wantByTag := []model.TimeTotal{
+		{Key: "client-a", Seconds: 6000},
+		{Key: "dev", Seconds: 4200},
+	}
+	if !reflect.DeepEqual(report.ByTag, wantByTag) {
+		t.Errorf("ByTag = %v, want %v", report.ByTag, wantByTag)
+	}
+	wantByDay := []model.TimeTotal{
+		{Key: "2024-05-01", Seconds: 5400},
+		{Key: "2024-05-02", Seconds: 600},
+		{Key: "2024-05-03", Seconds: 1800},
+	}
+	if !reflect.DeepEqual(report.ByDay, wantByDay) {
+		t.Errorf("ByDay = %v, want %v", report.ByDay, wantByDay)
+	}
+}
+
+func TestBuildTimeReport_Empty(t *testing.T) {
+	report := buildTimeReport(nil, nil)
+	if report.TotalSeconds != 0 || len(report.ByList) != 0 || len(report.ByTag) != 0 || len(report.ByDay) != 0 {
+		t.Errorf("buildTimeReport(nil) = %+v, want an empty report", report)
+	}
+	if report.ByList == nil || report.ByTag == nil || report.ByDay == nil {
+		t.Error("empty report groups must encode as empty arrays")
+	}
+}
+
+func TestValidateTimeEntry(t *testing.T) {
+	now := time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+
+	testTable := []struct {
+		name    string
+		input   model.TimeEntryInput
+		wantErr bool
+	}{
+		{name: "Valid", input: model.TimeEntryInput{Start: now.Add(-2 * time.Hour), End: now.Add(-time.Hour)}},
+		{name: "Missing Start", input: model.TimeEntryInput{End: now}, wantErr: true},
+		{name: "Ends Before Start", input: model.TimeEntryInput{Start: now.Add(-time.Hour), End: now.Add(-2 * time.Hour)}, wantErr: true},
+		{name: "Zero Length", input: model.TimeEntryInput{Start: now.Add(-time.Hour), End: now.Add(-time.Hour)}, wantErr: true},
+		{name: "Too Long", input: model.TimeEntryInput{Start: now.Add(-25 * time.Hour), End: now}, wantErr: true},
+		{name: "In The Future", input: model.TimeEntryInput{Start: now, End: now.Add(time.Hour)}, wantErr: true},
+	}
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateTimeEntry(tt.input, now); (err != nil) != tt.wantErr {
+				t.Errorf("validateTimeEntry() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
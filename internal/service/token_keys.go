@@ -0,0 +1,209 @@
+package service
+
+import (
+	"TaskManager/internal/domain/model"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"github.com/golang-jwt/jwt/v5"
+	"math/big"
+	"os"
+	"time"
+)
+
+// TokenSettings configures how access tokens are signed and verified.
+type TokenSettings struct {
+	// Secret is the HS256 key. Tokens signed with it carry no key ID.
+	Secret string
+	// Keys are asymmetric keys identified by their ID. Every key verifies tokens;
+	// keys without a private key only verify, which keeps tokens valid while a key is rotated out.
+	Keys []KeySettings
+	// SigningKey is the ID of the key that signs new tokens. By default the first key with a private key
+	// signs, or the secret when there is none.
+	SigningKey string
+	TTL        time.Duration
+}
+
+// KeySettings locates a PEM encoded RSA or Ed25519 key. The algorithm, RS256 or EdDSA, follows from the key type.
+type KeySettings struct {
+	Id             string
+	PrivateKeyFile string
+	PublicKeyFile  string
+}
+
+// TokenKeys holds the keys that sign and verify access tokens.
+type TokenKeys struct {
+	signing *tokenKey
+	secret  *tokenKey
+	keys    map[string]*tokenKey
+	jwks    model.JSONWebKeySet
+	ttl     time.Duration
+}
+
+type tokenKey struct {
+	id      string
+	method  jwt.SigningMethod
+	private interface{}
+	public  interface{}
+}
+
+// NewTokenKeys loads the configured keys. It fails when no key is configured at all,
+// so the server never signs tokens with an empty secret.
+func NewTokenKeys(settings TokenSettings) (*TokenKeys, error) {
+	k := &TokenKeys{
+		keys: map[string]*tokenKey{},
+		jwks: model.JSONWebKeySet{Keys: []model.JSONWebKey{}},
+		ttl:  settings.TTL,
+	}
+	if k.ttl <= 0 {
+		k.ttl = tokenTTL
+	}
+	if settings.Secret != "" {
+		k.secret = &tokenKey{method: jwt.SigningMethodHS256, private: []byte(settings.Secret), public: []byte(settings.Secret)}
+	}
+
+	for _, keySettings := range settings.Keys {
+		key, err := loadTokenKey(keySettings)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := k.keys[key.id]; ok {
+			return nil, fmt.Errorf("duplicate signing key id %q", key.id)
+		}
+		k.keys[key.id] = key
+		k.jwks.Keys = append(k.jwks.Keys, key.jwk())
+		if k.signing == nil && settings.SigningKey == "" && key.private != nil {
+			k.signing = key
+		}
+	}
+
+	if settings.SigningKey != "" {
+		key, ok := k.keys[settings.SigningKey]
+		if !ok {
+			return nil, fmt.Errorf("signing key %q is not configured", settings.SigningKey)
+		}
+		if key.private == nil {
+			return nil, fmt.Errorf("signing key %q has no private key", settings.SigningKey)
+		}
+		k.signing = key
+	}
+	if k.signing == nil {
+		k.signing = k.secret
+	}
+	if k.signing == nil {
+		return nil, errors.New("no token signing key configured: set SIGNING_KEY or configure jwt.keys")
+	}
+	return k, nil
+}
+
+// Sign returns a token for the claims signed with the signing key.
+func (k *TokenKeys) Sign(claims jwt.Claims) (string, error) {
+	token := jwt.NewWithClaims(k.signing.method, claims)
+	if k.signing.id != "" {
+		token.Header["kid"] = k.signing.id
+	}
+	return token.SignedString(k.signing.private)
+}
+
+// Parse verifies a token with the key named by its kid header, or the secret when it has none,
+// and decodes its claims. The algorithm must be the one of that key.
+func (k *TokenKeys) Parse(tokenString string, claims jwt.Claims) (*jwt.Token, error) {
+	return jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		key := k.secret
+		if kid, ok := token.Header["kid"].(string); ok {
+			key = k.keys[kid]
+		}
+		if key == nil {
+			return nil, fmt.Errorf("unknown signing key: %v", token.Header["kid"])
+		}
+		if token.Method.Alg() != key.method.Alg() {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return key.public, nil
+	})
+}
+
+// PublicKeys returns the asymmetric verification keys. The secret is never published.
+func (k *TokenKeys) PublicKeys() model.JSONWebKeySet {
+	return k.jwks
+}
+
+// loadTokenKey reads a key pair, or only a public key, from PEM files.
+func loadTokenKey(settings KeySettings) (*tokenKey, error) {
+	if settings.Id == "" {
+		return nil, errors.New("signing keys must have an id")
+	}
+	key := &tokenKey{id: settings.Id}
+
+	if settings.PrivateKeyFile != "" {
+		block, err := readPEM(settings.PrivateKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		private, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			if private, err = x509.ParsePKCS1PrivateKey(block.Bytes); err != nil {
+				return nil, fmt.Errorf("error parsing private key %s: %w", settings.PrivateKeyFile, err)
+			}
+		}
+		signer, ok := private.(crypto.Signer)
+		if !ok {
+			return nil, fmt.Errorf("unsupported private key type %T in %s", private, settings.PrivateKeyFile)
+		}
+		key.private = private
+		key.public = signer.Public()
+	} else if settings.PublicKeyFile != "" {
+		block, err := readPEM(settings.PublicKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		if key.public, err = x509.ParsePKIXPublicKey(block.Bytes); err != nil {
+			return nil, fmt.Errorf("error parsing public key %s: %w", settings.PublicKeyFile, err)
+		}
+	} else {
+		return nil, fmt.Errorf("signing key %q needs a private or public key file", settings.Id)
+	}
+
+	switch key.public.(type) {
+	case *rsa.PublicKey:
+		key.method = jwt.SigningMethodRS256
+	case ed25519.PublicKey:
+		key.method = jwt.SigningMethodEdDSA
+	default:
+		return nil, fmt.Errorf("signing key %q: unsupported key type %T, use RSA or Ed25519", settings.Id, key.public)
+	}
+	return key, nil
+}
+
+func readPEM(path string) (*pem.Block, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading key: %w", err)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM data in %s", path)
+	}
+	return block, nil
+}
+
+// jwk describes the public half of the key.
+func (k *tokenKey) jwk() model.JSONWebKey {
+	jwk := model.JSONWebKey{Use: "sig", Alg: k.method.Alg(), Kid: k.id}
+	switch public := k.public.(type) {
+	case *rsa.PublicKey:
+		jwk.Kty = "RSA"
+		jwk.N = base64.RawURLEncoding.EncodeToString(public.N.Bytes())
+		jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(public.E)).Bytes())
+	case ed25519.PublicKey:
+		jwk.Kty = "OKP"
+		jwk.Crv = "Ed25519"
+		jwk.X = base64.RawURLEncoding.EncodeToString(public)
+	}
+	return jwk
+}
@@ -0,0 +1,188 @@
+package service
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"github.com/golang-jwt/jwt/v5"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// writeKeyFiles writes the private and public halves of key as PEM files and returns their paths.
+func writeKeyFiles(t *testing.T, name string, key crypto.Signer) (string, string) {
+	t.Helper()
+	dir := t.TempDir()
+	private, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	public, err := x509.MarshalPKIXPublicKey(key.Public())
+	if err != nil {
+		t.Fatal(err)
+	}
+	privatePath := filepath.Join(dir, name+".pem")
+	publicPath := filepath.Join(dir, name+".pub.pem")
+	if err := os.WriteFile(privatePath, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: private}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(publicPath, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: public}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return privatePath, publicPath
+}
+
+func testClaims() *tokenClaims {
+	return &tokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour))},
+		UserId:           "user-1",
+	}
+}
+
+func TestNewTokenKeys_Errors(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, rsaPublic := writeKeyFiles(t, "rsa", rsaKey)
+
+	testTable := []struct {
+		name     string
+		settings TokenSettings
+	}{
+		{name: "No Key", settings: TokenSettings{}},
+		{name: "Unknown Signing Key", settings: TokenSettings{Secret: "s", SigningKey: "missing"}},
+		{name: "Signing Key Without Private Key", settings: TokenSettings{
+			Keys:       []KeySettings{{Id: "old", PublicKeyFile: rsaPublic}},
+			SigningKey: "old",
+		}},
+		{name: "Only Public Keys", settings: TokenSettings{Keys: []KeySettings{{Id: "old", PublicKeyFile: rsaPublic}}}},
+		{name: "Missing Id", settings: TokenSettings{Keys: []KeySettings{{PublicKeyFile: rsaPublic}}}},
+		{name: "Missing File", settings: TokenSettings{Keys: []KeySettings{{Id: "a", PrivateKeyFile: "/nonexistent.pem"}}}},
+	}
+
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewTokenKeys(tt.settings); err == nil {
+				t.Error("NewTokenKeys() expected an error")
+			}
+		})
+	}
+}
+
+func TestTokenKeys_Rotation(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsaPrivate, rsaPublic := writeKeyFiles(t, "rsa", rsaKey)
+	edPrivate, _ := writeKeyFiles(t, "ed", edKey)
+
+	before, err := NewTokenKeys(TokenSettings{Secret: "legacy", Keys: []KeySettings{{Id: "2026-01", PrivateKeyFile: rsaPrivate}}})
+	if err != nil {
+		t.Fatalf("NewTokenKeys() error = %v", err)
+	}
+	oldToken, err := before.Sign(testClaims())
+	if err != nil {
+		t.Fatalf("Sign() error = %v", err)
+	}
+
+	// The RSA key is rotated out: it only verifies, and the Ed25519 key signs.
+	after, err := NewTokenKeys(TokenSettings{
+		Secret:     "legacy",
+		Keys:       []KeySettings{{Id: "2026-01", PublicKeyFile: rsaPublic}, {Id: "2026-07", PrivateKeyFile: edPrivate}},
+		SigningKey: "2026-07",
+	})
+	if err != nil {
+		t.Fatalf("NewTokenKeys() error = %v", err)
+	}
+	newToken, err := after.Sign(testClaims())
+	if err != nil {
+		t.Fatalf("Sign() error = %v", err)
+	}
+	legacyToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256, testClaims()).SignedString([]byte("legacy"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, want := range map[string]struct {
+		token string
+		alg   string
+	}{
+		"Old RSA Token":   {oldToken, "RS256"},
+		"New EdDSA Token": {newToken, "EdDSA"},
+		"Legacy HS256":    {legacyToken, "HS256"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			claims := &tokenClaims{}
+			token, err := after.Parse(want.token, claims)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if token.Method.Alg() != want.alg || claims.UserId != "user-1" {
+				t.Errorf("Parse() = %s token for %q, want %s for user-1", token.Method.Alg(), claims.UserId, want.alg)
+			}
+		})
+	}
+
+	jwks := after.PublicKeys()
+	if len(jwks.Keys) != 2 {
+		t.Fatalf("PublicKeys() = %+v, want the two asymmetric keys", jwks)
+	}
+	if k := jwks.Keys[0]; k.Kid != "2026-01" || k.Kty != "RSA" || k.Alg != "RS256" || k.N == "" || k.E != "AQAB" {
+		t.Errorf("RSA JWK = %+v", k)
+	}
+	if k := jwks.Keys[1]; k.Kid != "2026-07" || k.Kty != "OKP" || k.Crv != "Ed25519" || k.Alg != "EdDSA" || k.X == "" {
+		t.Errorf("Ed25519 JWK = %+v", k)
+	}
+}
+
+func TestTokenKeys_RejectsMismatchedTokens(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsaPrivate, _ := writeKeyFiles(t, "rsa", rsaKey)
+	keys, err := NewTokenKeys(TokenSettings{Secret: "legacy", Keys: []KeySettings{{Id: "rsa", PrivateKeyFile: rsaPrivate}}})
+	if err != nil {
+		t.Fatalf("NewTokenKeys() error = %v", err)
+	}
+
+	sign := func(method jwt.SigningMethod, kid string, key interface{}) string {
+		token := jwt.NewWithClaims(method, testClaims())
+		if kid != "" {
+			token.Header["kid"] = kid
+		}
+		signed, err := token.SignedString(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return signed
+	}
+
+	testTable := []struct {
+		name  string
+		token string
+	}{
+		{name: "HS256 With RSA Kid", token: sign(jwt.SigningMethodHS256, "rsa", []byte("legacy"))},
+		{name: "Unknown Kid", token: sign(jwt.SigningMethodHS256, "other", []byte("legacy"))},
+		{name: "Wrong Secret", token: sign(jwt.SigningMethodHS256, "", []byte("guess"))},
+	}
+
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := keys.Parse(tt.token, &tokenClaims{}); err == nil {
+				t.Error("Parse() expected an error")
+			}
+		})
+	}
+}
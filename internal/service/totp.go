@@ -0,0 +1,79 @@
+package service
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// TOTP parameters of RFC 6238, the defaults every authenticator app supports.
+const (
+	totpPeriod      = 30 * time.Second
+	totpDigits      = 6
+	totpModulus     = 1000000 // 10^totpDigits
+	totpSecretBytes = 20
+	// totpSkew is the number of steps a code may be early or late, to allow for clock drift.
+	totpSkew = 1
+)
+
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// newTOTPSecret returns a random base32-encoded TOTP secret.
+func newTOTPSecret() (string, error) {
+	b := make([]byte, totpSecretBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate TOTP secret: %w", err)
+	}
+	return totpEncoding.EncodeToString(b), nil
+}
+
+// totpStep returns the time step a moment falls in.
+func totpStep(at time.Time) int64 {
+	return at.Unix() / int64(totpPeriod/time.Second)
+}
+
+// totpCode returns the code of a time step, as computed by HOTP (RFC 4226) with HMAC-SHA1.
+func totpCode(secret []byte, step int64) string {
+	var counter [8]byte
+	binary.BigEndian.PutUint64(counter[:], uint64(step))
+	mac := hmac.New(sha1.New, secret)
+	mac.Write(counter[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%0*d", totpDigits, value%totpModulus)
+}
+
+// matchTOTP returns the step of the code if it is valid at the given time, allowing for clock drift.
+func matchTOTP(secret, code string, at time.Time) (int64, bool) {
+	key, err := totpEncoding.DecodeString(strings.ToUpper(secret))
+	if err != nil || len(code) != totpDigits {
+		return 0, false
+	}
+	now := totpStep(at)
+	for step := now - totpSkew; step <= now+totpSkew; step++ {
+		if hmac.Equal([]byte(totpCode(key, step)), []byte(code)) {
+			return step, true
+		}
+	}
+	return 0, false
+}
+
+// totpURI returns the otpauth URI that authenticator apps import, usually from a QR code.
+func totpURI(issuer, account, secret string) string {
+	query := url.Values{}
+	query.Set("secret", secret)
+	query.Set("issuer", issuer)
+	query.Set("algorithm", "SHA1")
+	query.Set("digits", fmt.Sprint(totpDigits))
+	query.Set("period", fmt.Sprint(int(totpPeriod/time.Second)))
+	label := url.PathEscape(issuer) + ":" + url.PathEscape(account)
+	return "otpauth://totp/" + label + "?" + query.Encode()
+}
@@ -0,0 +1,65 @@
+package service
+
+import (
+	"encoding/base32"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTotpCode(t *testing.T) {
+	// The SHA-1 test vectors of RFC 6238, truncated to six digits.
+	secret := []byte("12345678901234567890")
+	testTable := []struct {
+		at   int64
+		want string
+	}{
+		{at: 59, want: "287082"},
+		{at: 1111111109, want: "081804"},
+		{at: 1234567890, want: "005924"},
+		{at: 2000000000, want: "279037"},
+	}
+
+	for _, tt := range testTable {
+		if got := totpCode(secret, totpStep(time.Unix(tt.at, 0))); got != tt.want {
+			t.Errorf("totpCode() at %d = %s, want %s", tt.at, got, tt.want)
+		}
+	}
+}
+
+func TestMatchTOTP(t *testing.T) {
+	secret := base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString([]byte("12345678901234567890"))
+	at := time.Unix(1111111109, 0)
+
+	testTable := []struct {
+		name     string
+		code     string
+		at       time.Time
+		wantStep int64
+		wantOk   bool
+	}{
+		{name: "Current Step", code: "081804", at: at, wantStep: totpStep(at), wantOk: true},
+		{name: "Previous Step", code: "081804", at: at.Add(totpPeriod), wantStep: totpStep(at), wantOk: true},
+		{name: "Too Old", code: "081804", at: at.Add(2 * totpPeriod)},
+		{name: "Wrong Code", code: "000000", at: at},
+		{name: "Wrong Length", code: "81804", at: at},
+	}
+
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			step, ok := matchTOTP(secret, tt.code, tt.at)
+			if ok != tt.wantOk || step != tt.wantStep {
+				t.Errorf("matchTOTP() = %d, %v, want %d, %v", step, ok, tt.wantStep, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestTotpURI(t *testing.T) {
+	uri := totpURI("TaskManager", "alice smith", "JBSWY3DPEHPK3PXP")
+	for _, want := range []string{"otpauth://totp/TaskManager:alice%20smith?", "secret=JBSWY3DPEHPK3PXP", "issuer=TaskManager", "digits=6", "period=30"} {
+		if !strings.Contains(uri, want) {
+			t.Errorf("totpURI() = %s, want it to contain %s", uri, want)
+		}
+	}
+}
@@ -0,0 +1,255 @@
+package service
+
+import (
+	"TaskManager/internal/domain/model"
+	"TaskManager/internal/repository"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/crypto/bcrypt"
+	"strings"
+	"time"
+)
+
+const (
+	// twoFactorIssuer names the service in authenticator apps.
+	twoFactorIssuer   = "TaskManager"
+	recoveryCodeCount = 10
+	// maxTwoFactorFailures is the number of invalid codes after which only recovery codes are accepted.
+	maxTwoFactorFailures = 10
+	// challengeTTL is how long a user has to enter the code once the password was accepted.
+	challengeTTL = 5 * time.Minute
+	// challengePurpose marks the tokens that only continue a login and grant no access.
+	challengePurpose = "2fa"
+)
+
+var (
+	// ErrTwoFactorRequired is returned for logins of users with two-factor authentication, as a TwoFactorChallenge.
+	ErrTwoFactorRequired = errors.New("two-factor authentication required")
+	// ErrTwoFactorEnabled is returned when enrolling a user who already has two-factor authentication.
+	ErrTwoFactorEnabled = errors.New("two-factor authentication is already enabled")
+	// ErrTwoFactorNotEnabled is returned when confirming or disabling two-factor authentication the user does not have.
+	ErrTwoFactorNotEnabled = errors.New("two-factor authentication is not enabled")
+	// ErrInvalidTwoFactorCode is returned for wrong, reused or locked out codes.
+	ErrInvalidTwoFactorCode = errors.New("invalid two-factor code")
+	// ErrInvalidPassword is returned when re-authentication fails because of a wrong password.
+	ErrInvalidPassword = errors.New("invalid password")
+)
+
+// TwoFactorChallenge is returned instead of an access token when the password of a user with two-factor
+// authentication was accepted. Its token is exchanged for an access token with TwoFactor.VerifyTwoFactor.
+type TwoFactorChallenge struct {
+	Token string
+}
+
+func (c *TwoFactorChallenge) Error() string {
+	return ErrTwoFactorRequired.Error()
+}
+
+func (c *TwoFactorChallenge) Unwrap() error {
+	return ErrTwoFactorRequired
+}
+
+// TwoFactorService manages TOTP enrollment and checks the second factor of logins.
+type TwoFactorService struct {
+	repo repository.Authorization
+	keys *TokenKeys
+	now  func() time.Time
+}
+
+// NewTwoFactorService initializes a new TwoFactorService with the provided repository and token keys.
+func NewTwoFactorService(repo repository.Authorization, keys *TokenKeys) *TwoFactorService {
+	return &TwoFactorService{repo: repo, keys: keys, now: time.Now}
+}
+
+// EnrollTwoFactor generates a TOTP secret for the user. Two-factor authentication is enabled once
+// ConfirmTwoFactor receives a code generated with it; enrolling again replaces an unconfirmed secret.
+func (s *TwoFactorService) EnrollTwoFactor(userId string) (model.TwoFactorEnrollment, error) {
+	user, err := s.repo.GetUserById(userId)
+	if err != nil {
+		return model.TwoFactorEnrollment{}, err
+	}
+	if user.TwoFactorEnabled() {
+		return model.TwoFactorEnrollment{}, ErrTwoFactorEnabled
+	}
+
+	secret, err := newTOTPSecret()
+	if err != nil {
+		return model.TwoFactorEnrollment{}, err
+	}
+	if err := s.repo.SetTwoFactor(userId, model.TwoFactor{Secret: secret}); err != nil {
+		return model.TwoFactorEnrollment{}, err
+	}
+	return model.TwoFactorEnrollment{Secret: secret, URI: totpURI(twoFactorIssuer, user.Username, secret)}, nil
+}
+
+// ConfirmTwoFactor enables two-factor authentication when the code matches the enrolled secret.
+// It returns the recovery codes, which are shown only this once.
+func (s *TwoFactorService) ConfirmTwoFactor(userId, code string) ([]string, error) {
+	user, err := s.repo.GetUserById(userId)
+	if err != nil {
+		return nil, err
+	}
+	if user.TwoFactor == nil {
+		return nil, ErrTwoFactorNotEnabled
+	}
+	if user.TwoFactor.Enabled {
+		return nil, ErrTwoFactorEnabled
+	}
+
+	step, ok := matchTOTP(user.TwoFactor.Secret, strings.TrimSpace(code), s.now())
+	if !ok {
+		return nil, ErrInvalidTwoFactorCode
+	}
+
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	twoFactor := model.TwoFactor{Secret: user.TwoFactor.Secret, Enabled: true, RecoveryCodes: hashes, LastStep: step}
+	if err := s.repo.SetTwoFactor(userId, twoFactor); err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+// VerifyTwoFactor completes a login: it returns an access token when the code is valid for the user
+// the challenge was issued to.
+func (s *TwoFactorService) VerifyTwoFactor(challenge, code string) (string, error) {
+	userId, err := parseChallenge(s.keys, challenge)
+	if err != nil {
+		return "", err
+	}
+	user, err := s.repo.GetUserById(userId)
+	if err != nil {
+		return "", fmt.Errorf("failed to get user: %w", err)
+	}
+	if user.Disabled {
+		return "", fmt.Errorf("account is disabled")
+	}
+	if !user.TwoFactorEnabled() {
+		return "", ErrTwoFactorNotEnabled
+	}
+
+	if err := s.checkCode(user, code); err != nil {
+		return "", err
+	}
+	return issueToken(s.keys, user)
+}
+
+// DisableTwoFactor turns off two-factor authentication after the user proved their identity again
+// with their password and a code. Users who sign in through an OIDC provider were given a random password
+// they do not know, so the code alone proves their identity.
+func (s *TwoFactorService) DisableTwoFactor(userId string, input model.DisableTwoFactorInput) error {
+	user, err := s.repo.GetUserById(userId)
+	if err != nil {
+		return err
+	}
+	if !user.TwoFactorEnabled() {
+		return ErrTwoFactorNotEnabled
+	}
+	if len(user.Identities) == 0 {
+		if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(input.Password)); err != nil {
+			return ErrInvalidPassword
+		}
+	}
+
+	if err := s.checkCode(user, input.Code); err != nil {
+		return err
+	}
+	return s.repo.RemoveTwoFactor(userId)
+}
+
+// checkCode accepts a TOTP code that was not used before or an unused recovery code. After too many
+// invalid codes, only recovery codes are accepted, so the codes of the authenticator app cannot be guessed.
+func (s *TwoFactorService) checkCode(user model.User, code string) error {
+	userId := user.Id.Hex()
+	code = strings.TrimSpace(code)
+
+	// The repository checks the failures again, since other logins may have added some since the user was read.
+	if user.TwoFactor.Failures < maxTwoFactorFailures {
+		if step, ok := matchTOTP(user.TwoFactor.Secret, code, s.now()); ok {
+			accepted, err := s.repo.AcceptTwoFactorStep(userId, step, maxTwoFactorFailures)
+			if err != nil {
+				return err
+			}
+			if !accepted {
+				return ErrInvalidTwoFactorCode
+			}
+			return nil
+		}
+	}
+
+	used, err := s.repo.UseRecoveryCode(userId, hashRecoveryCode(code))
+	if err != nil {
+		return err
+	}
+	if used {
+		return nil
+	}
+	if err := s.repo.RecordTwoFactorFailure(userId, maxTwoFactorFailures); err != nil {
+		return err
+	}
+	return ErrInvalidTwoFactorCode
+}
+
+// loginToken returns an access token for a user who passed the first factor, or a TwoFactorChallenge
+// when the user also has to enter a code.
+func loginToken(keys *TokenKeys, user model.User) (string, error) {
+	if !user.TwoFactorEnabled() {
+		return issueToken(keys, user)
+	}
+	challenge, err := keys.Sign(&tokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(challengeTTL)),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+		},
+		UserId:  user.Id.Hex(),
+		Purpose: challengePurpose,
+	})
+	if err != nil {
+		return "", err
+	}
+	return "", &TwoFactorChallenge{Token: challenge}
+}
+
+// parseChallenge returns the user a login challenge was issued to.
+func parseChallenge(keys *TokenKeys, challenge string) (string, error) {
+	parsedToken, err := keys.Parse(challenge, &tokenClaims{})
+	if err != nil {
+		return "", fmt.Errorf("invalid or expired challenge")
+	}
+	claims, ok := parsedToken.Claims.(*tokenClaims)
+	if !ok || !parsedToken.Valid || claims.Purpose != challengePurpose {
+		return "", fmt.Errorf("invalid or expired challenge")
+	}
+	return claims.UserId, nil
+}
+
+// newRecoveryCodes returns new recovery codes and the hashes under which they are stored.
+func newRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, 0, recoveryCodeCount)
+	hashes := make([]string, 0, recoveryCodeCount)
+	for range recoveryCodeCount {
+		b := make([]byte, 8)
+		if _, err := rand.Read(b); err != nil {
+			return nil, nil, fmt.Errorf("failed to generate recovery code: %w", err)
+		}
+		value := strings.ToLower(totpEncoding.EncodeToString(b))[:10]
+		code := value[:5] + "-" + value[5:]
+		codes = append(codes, code)
+		hashes = append(hashes, hashRecoveryCode(code))
+	}
+	return codes, hashes, nil
+}
+
+// hashRecoveryCode returns the hex-encoded SHA-256 hash under which a recovery code is stored.
+// Codes are compared without case and dashes, as users type them.
+func hashRecoveryCode(code string) string {
+	normalized := strings.ToLower(strings.ReplaceAll(code, "-", ""))
+	sum := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(sum[:])
+}
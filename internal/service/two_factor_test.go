@@ -0,0 +1,247 @@
+package service
+
+import (
+	"TaskManager/internal/domain/model"
+	"TaskManager/internal/repository"
+	"errors"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+// fakeTwoFactorUsers keeps a single user and applies the two-factor updates like the Mongo repository.
+type fakeTwoFactorUsers struct {
+	repository.Authorization
+	user model.User
+}
+
+func (f *fakeTwoFactorUsers) GetUser(username, password string) (model.User, error) {
+	return f.user, nil
+}
+
+func (f *fakeTwoFactorUsers) GetUserById(id string) (model.User, error) {
+	user := f.user
+	if user.TwoFactor != nil {
+		twoFactor := *user.TwoFactor
+		twoFactor.RecoveryCodes = slices.Clone(twoFactor.RecoveryCodes)
+		user.TwoFactor = &twoFactor
+	}
+	return user, nil
+}
+
+func (f *fakeTwoFactorUsers) SetTwoFactor(id string, twoFactor model.TwoFactor) error {
+	f.user.TwoFactor = &twoFactor
+	return nil
+}
+
+func (f *fakeTwoFactorUsers) RemoveTwoFactor(id string) error {
+	f.user.TwoFactor = nil
+	return nil
+}
+
+func (f *fakeTwoFactorUsers) AcceptTwoFactorStep(id string, step int64, maxFailures int) (bool, error) {
+	if f.user.TwoFactor.LastStep >= step || f.user.TwoFactor.Failures >= maxFailures {
+		return false, nil
+	}
+	f.user.TwoFactor.LastStep, f.user.TwoFactor.Failures = step, 0
+	return true, nil
+}
+
+func (f *fakeTwoFactorUsers) UseRecoveryCode(id string, hash string) (bool, error) {
+	i := slices.Index(f.user.TwoFactor.RecoveryCodes, hash)
+	if i < 0 {
+		return false, nil
+	}
+	f.user.TwoFactor.RecoveryCodes = slices.Delete(f.user.TwoFactor.RecoveryCodes, i, i+1)
+	f.user.TwoFactor.Failures = 0
+	return true, nil
+}
+
+func (f *fakeTwoFactorUsers) RecordTwoFactorFailure(id string, maxFailures int) error {
+	if f.user.TwoFactor.Failures < maxFailures {
+		f.user.TwoFactor.Failures++
+	}
+	return nil
+}
+
+// enrolledTwoFactor returns a service for a user who enrolled and confirmed two-factor authentication,
+// the clock it reads and the recovery codes.
+func enrolledTwoFactor(t *testing.T) (*TwoFactorService, *fakeTwoFactorUsers, *time.Time, []string) {
+	t.Helper()
+	keys, err := NewTokenKeys(TokenSettings{Secret: "secret"})
+	if err != nil {
+		t.Fatalf("NewTokenKeys() error = %v", err)
+	}
+	users := &fakeTwoFactorUsers{user: model.User{
+		Id:       bson.NewObjectID(),
+		Username: "alice",
+		Password: generatePasswordHash("Password_1"),
+	}}
+	now := time.Unix(1700000000, 0)
+	s := NewTwoFactorService(users, keys)
+	s.now = func() time.Time { return now }
+
+	enrollment, err := s.EnrollTwoFactor(users.user.Id.Hex())
+	if err != nil {
+		t.Fatalf("EnrollTwoFactor() error = %v", err)
+	}
+	if !strings.Contains(enrollment.URI, "secret="+enrollment.Secret) {
+		t.Errorf("EnrollTwoFactor() URI = %s, want the secret", enrollment.URI)
+	}
+	codes, err := s.ConfirmTwoFactor(users.user.Id.Hex(), currentCode(t, enrollment.Secret, now))
+	if err != nil {
+		t.Fatalf("ConfirmTwoFactor() error = %v", err)
+	}
+	if len(codes) != recoveryCodeCount || slices.Contains(users.user.TwoFactor.RecoveryCodes, codes[0]) {
+		t.Fatalf("ConfirmTwoFactor() = %v, want %d codes stored hashed", codes, recoveryCodeCount)
+	}
+	return s, users, &now, codes
+}
+
+func currentCode(t *testing.T, secret string, at time.Time) string {
+	t.Helper()
+	key, err := totpEncoding.DecodeString(secret)
+	if err != nil {
+		t.Fatalf("decoding secret: %v", err)
+	}
+	return totpCode(key, totpStep(at))
+}
+
+// challenge logs the user in with their password and returns the challenge of the second step.
+func challenge(t *testing.T, s *TwoFactorService, users *fakeTwoFactorUsers) string {
+	t.Helper()
+	_, err := NewAuthService(users, s.keys).GenerateToken("alice", "Password_1")
+	var c *TwoFactorChallenge
+	if !errors.As(err, &c) {
+		t.Fatalf("GenerateToken() error = %v, want a TwoFactorChallenge", err)
+	}
+	return c.Token
+}
+
+func TestTwoFactor_Login(t *testing.T) {
+	s, users, now, codes := enrolledTwoFactor(t)
+	auth := NewAuthService(users, s.keys)
+	secret := users.user.TwoFactor.Secret
+
+	c := challenge(t, s, users)
+	if _, err := auth.ParseToken(c); err == nil {
+		t.Error("ParseToken() accepted a login challenge as an access token")
+	}
+	if _, err := s.VerifyTwoFactor(c, currentCode(t, secret, *now)); !errors.Is(err, ErrInvalidTwoFactorCode) {
+		t.Errorf("VerifyTwoFactor() with the code used to confirm error = %v, want ErrInvalidTwoFactorCode", err)
+	}
+
+	*now = now.Add(totpPeriod)
+	token, err := s.VerifyTwoFactor(c, currentCode(t, secret, *now))
+	if err != nil {
+		t.Fatalf("VerifyTwoFactor() error = %v", err)
+	}
+	if userId, err := auth.ParseToken(token); err != nil || userId != users.user.Id.Hex() {
+		t.Errorf("ParseToken() = %s, %v, want the user", userId, err)
+	}
+
+	if _, err := s.VerifyTwoFactor(c, strings.ToUpper(codes[0])); err != nil {
+		t.Errorf("VerifyTwoFactor() with a recovery code error = %v", err)
+	}
+	if _, err := s.VerifyTwoFactor(c, codes[0]); !errors.Is(err, ErrInvalidTwoFactorCode) {
+		t.Errorf("VerifyTwoFactor() with a used recovery code error = %v, want ErrInvalidTwoFactorCode", err)
+	}
+	if _, err := s.VerifyTwoFactor("forged", codes[1]); err == nil {
+		t.Error("VerifyTwoFactor() with an invalid challenge expected an error")
+	}
+}
+
+func TestTwoFactor_LocksCodesAfterFailures(t *testing.T) {
+	s, users, now, codes := enrolledTwoFactor(t)
+	c := challenge(t, s, users)
+
+	for range maxTwoFactorFailures {
+		if _, err := s.VerifyTwoFactor(c, "000000"); !errors.Is(err, ErrInvalidTwoFactorCode) {
+			t.Fatalf("VerifyTwoFactor() error = %v, want ErrInvalidTwoFactorCode", err)
+		}
+	}
+	*now = now.Add(totpPeriod)
+	if _, err := s.VerifyTwoFactor(c, currentCode(t, users.user.TwoFactor.Secret, *now)); !errors.Is(err, ErrInvalidTwoFactorCode) {
+		t.Errorf("VerifyTwoFactor() after too many failures error = %v, want ErrInvalidTwoFactorCode", err)
+	}
+	if _, err := s.VerifyTwoFactor(c, codes[0]); err != nil {
+		t.Fatalf("VerifyTwoFactor() with a recovery code error = %v", err)
+	}
+	if users.user.TwoFactor.Failures != 0 {
+		t.Errorf("failures = %d after a recovery code, want 0", users.user.TwoFactor.Failures)
+	}
+}
+
+func TestTwoFactor_LockoutFromConcurrentLogin(t *testing.T) {
+	s, users, now, _ := enrolledTwoFactor(t)
+	// The user was read before other logins used up the remaining attempts.
+	stale, _ := users.GetUserById(users.user.Id.Hex())
+	users.user.TwoFactor.Failures = maxTwoFactorFailures
+
+	*now = now.Add(totpPeriod)
+	if err := s.checkCode(stale, currentCode(t, users.user.TwoFactor.Secret, *now)); !errors.Is(err, ErrInvalidTwoFactorCode) {
+		t.Errorf("checkCode() error = %v, want ErrInvalidTwoFactorCode", err)
+	}
+	if users.user.TwoFactor.Failures != maxTwoFactorFailures {
+		t.Errorf("failures = %d, want them to stay at %d", users.user.TwoFactor.Failures, maxTwoFactorFailures)
+	}
+}
+
+func TestTwoFactor_Disable(t *testing.T) {
+	testTable := []struct {
+		name     string
+		oidc     bool
+		password string
+		code     func(secret string, now time.Time, codes []string) string
+		wantErr  error
+	}{
+		{
+			name:     "Wrong Password",
+			password: "wrong",
+			code:     func(secret string, now time.Time, codes []string) string { return codes[0] },
+			wantErr:  ErrInvalidPassword,
+		},
+		{
+			name:     "Wrong Code",
+			password: "Password_1",
+			code:     func(secret string, now time.Time, codes []string) string { return "000000" },
+			wantErr:  ErrInvalidTwoFactorCode,
+		},
+		{
+			name:     "Recovery Code",
+			password: "Password_1",
+			code:     func(secret string, now time.Time, codes []string) string { return codes[0] },
+		},
+		{
+			name: "OIDC User Without Password",
+			oidc: true,
+			code: func(secret string, now time.Time, codes []string) string { return codes[0] },
+		},
+		{
+			name:    "OIDC User Wrong Code",
+			oidc:    true,
+			code:    func(secret string, now time.Time, codes []string) string { return "000000" },
+			wantErr: ErrInvalidTwoFactorCode,
+		},
+	}
+
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			s, users, now, codes := enrolledTwoFactor(t)
+			if tt.oidc {
+				users.user.Identities = []model.ExternalIdentity{{Issuer: "https://idp.example.com", Subject: "123"}}
+			}
+			input := model.DisableTwoFactorInput{Password: tt.password, Code: tt.code(users.user.TwoFactor.Secret, *now, codes)}
+
+			err := s.DisableTwoFactor(users.user.Id.Hex(), input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("DisableTwoFactor() error = %v, want %v", err, tt.wantErr)
+			}
+			if enabled := users.user.TwoFactorEnabled(); enabled != (tt.wantErr != nil) {
+				t.Errorf("two-factor enabled = %v after DisableTwoFactor()", enabled)
+			}
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package service
+
+import (
+	"TaskManager/internal/repository"
+)
+
+// UserAdminService performs the account operations reserved for administrators.
+// It is not part of Service, so only the admin tooling can reach it.
+type UserAdminService struct {
+	repo repository.Authorization
+}
+
+// NewUserAdminService initializes a new UserAdminService with the provided repository.
+func NewUserAdminService(repo repository.Authorization) *UserAdminService {
+	return &UserAdminService{repo: repo}
+}
+
+// ResetPassword replaces the password of a user after checking it against the same rules as on registration.
+func (s *UserAdminService) ResetPassword(userId, password string) error {
+	if err := validatePassword(password); err != nil {
+		return err
+	}
+	return s.repo.SetPassword(userId, generatePasswordHash(password))
+}
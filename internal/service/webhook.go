@@ -0,0 +1,396 @@
+package service
+
+import (
+	"TaskManager/internal/domain/model"
+	"TaskManager/internal/repository"
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.uber.org/zap"
+	"net"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
+)
+
+const (
+	webhookQueueSize       = 1024
+	webhookTimeout         = 10 * time.Second
+	maxWebhookAttempts     = 5
+	webhookBaseBackoff     = 10 * time.Second
+	webhookSignatureHeader = "X-TaskManager-Signature"
+	webhookEventHeader     = "X-TaskManager-Event"
+	webhookDeliveryHeader  = "X-TaskManager-Delivery"
+	// webhookWorkers bounds the number of webhooks delivered to at the same time.
+	webhookWorkers = 8
+)
+
+// errWebhookAddress is returned for webhook URLs that point into the server's own network.
+var errWebhookAddress = errors.New("webhook URL must not point to a loopback, link-local or private address")
+
+// WebhookService manages webhook subscriptions and delivers task list events to them.
+type WebhookService struct {
+	repo   repository.Webhook
+	client *http.Client
+	queue  chan model.Event
+	// dropped counts the events discarded because the queue was full, until Run logs them.
+	dropped atomic.Int64
+	// workers limits concurrent deliveries; busy holds the webhooks being delivered to,
+	// so the deliveries of one webhook are sent in order and a slow one does not hold up the others.
+	workers chan struct{}
+	mu      sync.Mutex
+	busy    map[string]bool
+}
+
+// webhookPayload is the JSON body posted to webhook subscribers.
+type webhookPayload struct {
+	Id         string         `json:"id"`
+	Event      string         `json:"event"`
+	OccurredAt time.Time      `json:"occurred_at"`
+	Data       model.TaskList `json:"data"`
+}
+
+// NewWebhookService initializes a new WebhookService with the provided repository.
+func NewWebhookService(repo repository.Webhook) *WebhookService {
+	return &WebhookService{
+		repo:    repo,
+		client:  newWebhookClient(),
+		queue:   make(chan model.Event, webhookQueueSize),
+		workers: make(chan struct{}, webhookWorkers),
+		busy:    map[string]bool{},
+	}
+}
+
+// newWebhookClient returns an HTTP client that refuses to connect to loopback, link-local and private
+// addresses. The check runs on the resolved address of every connection, redirects included, so a
+// hostname cannot be pointed at an internal service after the webhook was created.
+func newWebhookClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout: webhookTimeout,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || isInternalIP(ip) {
+				return errWebhookAddress
+			}
+			return nil
+		},
+	}
+	// No proxy: the address check must see the webhook's own address.
+	transport := &http.Transport{DialContext: dialer.DialContext, TLSHandshakeTimeout: webhookTimeout}
+	return &http.Client{Timeout: webhookTimeout, Transport: transport}
+}
+
+// isInternalIP reports whether an address belongs to the server's own host or network.
+func isInternalIP(ip net.IP) bool {
+	return ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || ip.IsUnspecified()
+}
+
+// Create subscribes the user to the webhook's event types, generating a secret when none is provided.
+// Subscribing to no event types subscribes to all of them.
+func (s *WebhookService) Create(userId string, hook model.Webhook) (model.Webhook, error) {
+	if err := validateWebhook(hook); err != nil {
+		return model.Webhook{}, err
+	}
+	if len(hook.Events) == 0 {
+		hook.Events = model.EventTypes
+	}
+	if hook.Secret == "" {
+		secret, err := generateWebhookSecret()
+		if err != nil {
+			return model.Webhook{}, err
+		}
+		hook.Secret = secret
+	}
+	hook.CreatedAt = time.Now()
+
+	id, err := s.repo.Create(userId, hook)
+	if err != nil {
+		return model.Webhook{}, err
+	}
+	return s.repo.GetById(userId, id)
+}
+
+// GetAll retrieves all webhook subscriptions of the user with their secrets hidden.
+func (s *WebhookService) GetAll(userId string) ([]model.Webhook, error) {
+	hooks, err := s.repo.GetAll(userId)
+	if err != nil {
+		return nil, err
+	}
+	for i := range hooks {
+		hooks[i].Secret = ""
+	}
+	return hooks, nil
+}
+
+// GetById retrieves a webhook subscription of the user with its secret hidden.
+func (s *WebhookService) GetById(userId string, id string) (model.Webhook, error) {
+	hook, err := s.repo.GetById(userId, id)
+	if err != nil {
+		return model.Webhook{}, err
+	}
+	hook.Secret = ""
+	return hook, nil
+}
+
+// Delete removes a webhook subscription of the user.
+func (s *WebhookService) Delete(userId string, id string) error {
+	return s.repo.Delete(userId, id)
+}
+
+// GetDeliveries retrieves the delivery log of a webhook subscription of the user.
+func (s *WebhookService) GetDeliveries(userId string, id string) ([]model.WebhookDelivery, error) {
+	if _, err := s.repo.GetById(userId, id); err != nil {
+		return nil, err
+	}
+	return s.repo.GetDeliveries(userId, id)
+}
+
+// Publish queues an event for delivery to the subscribed webhooks without blocking the caller.
+// Events are dropped when the queue is full; Run logs how many.
+func (s *WebhookService) Publish(event model.Event) {
+	select {
+	case s.queue <- event:
+	default:
+		s.dropped.Add(1)
+	}
+}
+
+// Run records queued events as deliveries and attempts pending deliveries,
+// retrying failed ones every interval with exponential backoff until the context is cancelled.
+func (s *WebhookService) Run(ctx context.Context, interval time.Duration, logger *zap.Logger) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event := <-s.queue:
+			if err := s.enqueue(event); err != nil {
+				logger.Error("Failed to record webhook deliveries", zap.String("event", event.Type), zap.Error(err))
+			}
+			s.attemptPending(ctx, logger)
+		case <-ticker.C:
+			if dropped := s.dropped.Swap(0); dropped > 0 {
+				logger.Warn("Dropped webhook events because the queue was full", zap.Int64("dropped", dropped))
+			}
+			s.attemptPending(ctx, logger)
+		}
+	}
+}
+
+// enqueue records a pending delivery of the event for every webhook subscribed to it.
+func (s *WebhookService) enqueue(event model.Event) error {
+	hooks, err := s.repo.GetByEvent(event.UserId, event.Type)
+	if err != nil {
+		return err
+	}
+
+	for _, hook := range hooks {
+		now := time.Now()
+		id := bson.NewObjectID()
+		payload, err := json.Marshal(webhookPayload{
+			Id:         id.Hex(),
+			Event:      event.Type,
+			OccurredAt: event.OccurredAt,
+			Data:       event.TaskList,
+		})
+		if err != nil {
+			return fmt.Errorf("error encoding webhook payload: %w", err)
+		}
+
+		err = s.repo.CreateDelivery(model.WebhookDelivery{
+			Id:            id,
+			WebhookId:     hook.Id.Hex(),
+			UserId:        event.UserId,
+			Event:         event.Type,
+			Payload:       string(payload),
+			Status:        model.WebhookDeliveryPending,
+			NextAttemptAt: now,
+			CreatedAt:     now,
+			UpdatedAt:     now,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// attemptPending starts delivering the pending deliveries that are due, one worker per webhook.
+// Webhooks that are still being delivered to, or for which no worker is free, are picked up on a later call.
+func (s *WebhookService) attemptPending(ctx context.Context, logger *zap.Logger) {
+	deliveries, err := s.repo.GetPendingDeliveries(time.Now())
+	if err != nil {
+		logger.Error("Failed to fetch pending webhook deliveries", zap.Error(err))
+		return
+	}
+
+	byWebhook := map[string][]model.WebhookDelivery{}
+	var order []string
+	for _, delivery := range deliveries {
+		if _, ok := byWebhook[delivery.WebhookId]; !ok {
+			order = append(order, delivery.WebhookId)
+		}
+		byWebhook[delivery.WebhookId] = append(byWebhook[delivery.WebhookId], delivery)
+	}
+
+	for _, webhookId := range order {
+		if !s.claim(webhookId) {
+			continue
+		}
+		go func(deliveries []model.WebhookDelivery) {
+			defer s.release(webhookId)
+			for _, delivery := range deliveries {
+				if err := s.attempt(ctx, delivery); err != nil {
+					logger.Error("Failed to deliver webhook",
+						zap.String("webhook_id", delivery.WebhookId),
+						zap.String("event", delivery.Event),
+						zap.Int("attempt", delivery.Attempts+1),
+						zap.Error(err),
+					)
+				}
+			}
+		}(byWebhook[webhookId])
+	}
+}
+
+// claim reserves a worker for delivering to the webhook. It fails when the webhook is busy or no worker is free.
+func (s *WebhookService) claim(webhookId string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.busy[webhookId] {
+		return false
+	}
+	select {
+	case s.workers <- struct{}{}:
+	default:
+		return false
+	}
+	s.busy[webhookId] = true
+	return true
+}
+
+// release frees the worker of a webhook.
+func (s *WebhookService) release(webhookId string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.busy, webhookId)
+	<-s.workers
+}
+
+// attempt sends a delivery once and records the outcome, scheduling a retry on failure.
+func (s *WebhookService) attempt(ctx context.Context, delivery model.WebhookDelivery) error {
+	delivery.Attempts++
+	delivery.UpdatedAt = time.Now()
+
+	hook, err := s.repo.GetById(delivery.UserId, delivery.WebhookId)
+	var sendErr error
+	if err != nil {
+		// The subscription no longer exists, so there is nothing to retry.
+		delivery.Attempts = maxWebhookAttempts
+		sendErr = err
+	} else {
+		delivery.ResponseCode, sendErr = s.send(ctx, hook, delivery)
+	}
+
+	switch {
+	case sendErr == nil:
+		delivery.Status = model.WebhookDeliverySucceeded
+		delivery.Error = ""
+	case delivery.Attempts >= maxWebhookAttempts:
+		delivery.Status = model.WebhookDeliveryFailed
+		delivery.Error = sendErr.Error()
+	default:
+		delivery.Error = sendErr.Error()
+		delivery.NextAttemptAt = time.Now().Add(webhookBackoff(delivery.Attempts))
+	}
+
+	if err := s.repo.UpdateDelivery(delivery); err != nil {
+		return err
+	}
+	return sendErr
+}
+
+// send posts the delivery payload signed with the webhook's secret and returns the response status code.
+func (s *WebhookService) send(ctx context.Context, hook model.Webhook, delivery model.WebhookDelivery) (int, error) {
+	body := []byte(delivery.Payload)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, hook.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("error creating webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(webhookEventHeader, delivery.Event)
+	req.Header.Set(webhookDeliveryHeader, delivery.Id.Hex())
+	req.Header.Set(webhookSignatureHeader, "sha256="+signWebhookPayload(hook.Secret, body))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("error sending webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// signWebhookPayload returns the hex-encoded HMAC-SHA256 of the body keyed with the secret.
+func signWebhookPayload(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// webhookBackoff returns the delay before the next attempt after the given number of attempts.
+func webhookBackoff(attempts int) time.Duration {
+	return webhookBaseBackoff << (attempts - 1)
+}
+
+// generateWebhookSecret returns a random hex-encoded secret.
+func generateWebhookSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate webhook secret: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// validateWebhook checks if the webhook subscription is valid.
+func validateWebhook(hook model.Webhook) error {
+	u, err := url.Parse(hook.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errors.New("webhook URL must be an absolute http or https URL")
+	}
+	// Hostnames are checked again when delivering, against the address they resolve to.
+	host := strings.ToLower(strings.TrimSuffix(u.Hostname(), "."))
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return errWebhookAddress
+	}
+	if ip := net.ParseIP(host); ip != nil && isInternalIP(ip) {
+		return errWebhookAddress
+	}
+	for _, event := range hook.Events {
+		if !slices.Contains(model.EventTypes, event) {
+			return fmt.Errorf("unknown event type %q", event)
+		}
+	}
+	return nil
+}
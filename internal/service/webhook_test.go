@@ -0,0 +1,182 @@
+package service
+
+import (
+	"TaskManager/internal/domain/model"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestValidateWebhook(t *testing.T) {
+	testTable := []struct {
+		name    string
+		hook    model.Webhook
+		wantErr bool
+	}{
+		{
+			name:    "Valid Webhook",
+			hook:    model.Webhook{URL: "https://example.com/hooks", Events: []string{model.EventTaskListCreated}},
+			wantErr: false,
+		},
+		{
+			name:    "All Events",
+			hook:    model.Webhook{URL: "http://hooks.example.com:9000/hooks"},
+			wantErr: false,
+		},
+		{
+			name:    "Localhost",
+			hook:    model.Webhook{URL: "http://localhost:9000/hooks"},
+			wantErr: true,
+		},
+		{
+			name:    "Loopback Address",
+			hook:    model.Webhook{URL: "http://127.0.0.1/hooks"},
+			wantErr: true,
+		},
+		{
+			name:    "Private Address",
+			hook:    model.Webhook{URL: "https://10.0.0.5/hooks"},
+			wantErr: true,
+		},
+		{
+			name:    "Link-Local Address",
+			hook:    model.Webhook{URL: "http://169.254.169.254/latest/meta-data"},
+			wantErr: true,
+		},
+		{
+			name:    "IPv6 Loopback",
+			hook:    model.Webhook{URL: "http://[::1]:8080/hooks"},
+			wantErr: true,
+		},
+		{
+			name:    "Relative URL",
+			hook:    model.Webhook{URL: "/hooks"},
+			wantErr: true,
+		},
+		{
+			name:    "Unsupported Scheme",
+			hook:    model.Webhook{URL: "ftp://example.com/hooks"},
+			wantErr: true,
+		},
+		{
+			name:    "Unknown Event",
+			hook:    model.Webhook{URL: "https://example.com/hooks", Events: []string{"task_list.archived"}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateWebhook(tt.hook)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateWebhook() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestWebhookBackoff(t *testing.T) {
+	testTable := []struct {
+		attempts int
+		expected time.Duration
+	}{
+		{attempts: 1, expected: 10 * time.Second},
+		{attempts: 2, expected: 20 * time.Second},
+		{attempts: 4, expected: 80 * time.Second},
+	}
+	for _, tt := range testTable {
+		if result := webhookBackoff(tt.attempts); result != tt.expected {
+			t.Errorf("webhookBackoff(%d) = %v, want %v", tt.attempts, result, tt.expected)
+		}
+	}
+}
+
+func TestWebhookService_SendSignsPayload(t *testing.T) {
+	const secret = "topsecret"
+	payload := `{"event":"task_list.created"}`
+
+	var signature, event string
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		signature = r.Header.Get(webhookSignatureHeader)
+		event = r.Header.Get(webhookEventHeader)
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	s := NewWebhookService(nil)
+	// The test server listens on loopback, which the service's own client refuses.
+	s.client = server.Client()
+	code, err := s.send(context.Background(),
+		model.Webhook{URL: server.URL, Secret: secret},
+		model.WebhookDelivery{Event: model.EventTaskListCreated, Payload: payload},
+	)
+	if err != nil || code != http.StatusOK {
+		t.Fatalf("send() = %d, %v", code, err)
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(payload))
+	expected := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+	if signature != expected {
+		t.Errorf("signature = %s, want %s", signature, expected)
+	}
+	if event != model.EventTaskListCreated {
+		t.Errorf("event header = %s, want %s", event, model.EventTaskListCreated)
+	}
+	if string(body) != payload {
+		t.Errorf("body = %s, want %s", body, payload)
+	}
+}
+
+func TestWebhookService_RefusesInternalAddresses(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("webhook delivered to a loopback address")
+	}))
+	defer server.Close()
+
+	_, err := NewWebhookService(nil).send(context.Background(),
+		model.Webhook{URL: server.URL},
+		model.WebhookDelivery{Event: model.EventTaskListCreated, Payload: "{}"},
+	)
+	if !errors.Is(err, errWebhookAddress) {
+		t.Errorf("send() error = %v, want errWebhookAddress", err)
+	}
+}
+
+func TestWebhookService_PublishDropsWhenFull(t *testing.T) {
+	s := NewWebhookService(nil)
+	for range webhookQueueSize + 3 {
+		s.Publish(model.Event{Type: model.EventTaskListCreated})
+	}
+	if len(s.queue) != webhookQueueSize || s.dropped.Load() != 3 {
+		t.Errorf("queued %d and dropped %d events, want %d and 3", len(s.queue), s.dropped.Load(), webhookQueueSize)
+	}
+}
+
+func TestWebhookService_ClaimLimitsWorkers(t *testing.T) {
+	s := NewWebhookService(nil)
+	if !s.claim("hook-0") || s.claim("hook-0") {
+		t.Fatal("claim() must reserve a webhook once")
+	}
+	for i := 1; i < webhookWorkers; i++ {
+		if !s.claim(fmt.Sprint("hook-", i)) {
+			t.Fatalf("claim() of worker %d failed", i)
+		}
+	}
+	if s.claim("hook-extra") {
+		t.Error("claim() succeeded with every worker busy")
+	}
+	s.release("hook-0")
+	if !s.claim("hook-extra") {
+		t.Error("claim() failed after a worker was released")
+	}
+}
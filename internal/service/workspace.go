@@ -0,0 +1,184 @@
+package service
+
+import (
+	"TaskManager/internal/domain/model"
+	"TaskManager/internal/repository"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const maxWorkspaceNameLength = 100
+
+// WorkspaceService manages workspaces and their members.
+type WorkspaceService struct {
+	repo  repository.Workspace
+	users repository.Authorization
+	lists repository.TaskList
+}
+
+// NewWorkspaceService initializes a new WorkspaceService with the provided repositories.
+func NewWorkspaceService(repo repository.Workspace, users repository.Authorization, lists repository.TaskList) *WorkspaceService {
+	return &WorkspaceService{
+		repo:  repo,
+		users: users,
+		lists: lists,
+	}
+}
+
+// Create creates a new workspace owned by the user.
+func (s *WorkspaceService) Create(userId string, input model.WorkspaceInput) (string, error) {
+	name, err := validateWorkspaceName(input.Name)
+	if err != nil {
+		return "", err
+	}
+
+	return s.repo.Create(model.Workspace{
+		Name:      name,
+		OwnerId:   userId,
+		Members:   []model.WorkspaceMember{{UserId: userId, Role: model.RoleOwner}},
+		CreatedAt: time.Now(),
+	})
+}
+
+// GetAll retrieves the workspaces the user is a member of.
+func (s *WorkspaceService) GetAll(userId string) ([]model.Workspace, error) {
+	return s.repo.GetAll(userId)
+}
+
+// GetById retrieves a workspace the user is a member of.
+func (s *WorkspaceService) GetById(userId string, id string) (model.Workspace, error) {
+	return s.repo.GetById(userId, id)
+}
+
+// Update renames a workspace. Only owners and admins can rename it.
+func (s *WorkspaceService) Update(userId string, id string, input model.WorkspaceInput) error {
+	name, err := validateWorkspaceName(input.Name)
+	if err != nil {
+		return err
+	}
+	if _, err := s.authorize(userId, id, model.RoleAdmin); err != nil {
+		return err
+	}
+	return s.repo.Update(id, name)
+}
+
+// Delete removes a workspace and moves its task lists back to their creators. Only the owner can delete it.
+func (s *WorkspaceService) Delete(userId string, id string) error {
+	if _, err := s.authorize(userId, id, model.RoleOwner); err != nil {
+		return err
+	}
+	if err := s.lists.DetachWorkspace(id); err != nil {
+		return err
+	}
+	return s.repo.Delete(id)
+}
+
+// AddMember adds a user to a workspace by username. Only owners and admins can add members.
+func (s *WorkspaceService) AddMember(userId string, id string, input model.WorkspaceMemberInput) error {
+	if err := validateMemberRole(input.Role); err != nil {
+		return err
+	}
+	workspace, err := s.authorize(userId, id, model.RoleAdmin)
+	if err != nil {
+		return err
+	}
+
+	user, err := s.users.GetUserByUsername(input.Username)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+	if workspace.Role(user.Id.Hex()) != "" {
+		return fmt.Errorf("user %s is already a member of the workspace", input.Username)
+	}
+
+	return s.repo.AddMember(id, model.WorkspaceMember{UserId: user.Id.Hex(), Role: input.Role})
+}
+
+// UpdateMember changes the role of a member. Only owners and admins can change roles, and the owner's role is fixed.
+func (s *WorkspaceService) UpdateMember(userId string, id string, memberId string, role string) error {
+	if err := validateMemberRole(role); err != nil {
+		return err
+	}
+	workspace, err := s.authorize(userId, id, model.RoleAdmin)
+	if err != nil {
+		return err
+	}
+
+	switch workspace.Role(memberId) {
+	case "":
+		return errors.New("user is not a member of the workspace")
+	case model.RoleOwner:
+		return errors.New("the owner's role cannot be changed")
+	}
+	return s.repo.UpdateMember(id, memberId, role)
+}
+
+// RemoveMember removes a member from a workspace. Owners and admins can remove others, and any member can leave.
+// The owner cannot be removed.
+func (s *WorkspaceService) RemoveMember(userId string, id string, memberId string) error {
+	required := model.RoleAdmin
+	if memberId == userId {
+		required = model.RoleMember
+	}
+	workspace, err := s.authorize(userId, id, required)
+	if err != nil {
+		return err
+	}
+
+	switch workspace.Role(memberId) {
+	case "":
+		return errors.New("user is not a member of the workspace")
+	case model.RoleOwner:
+		return errors.New("the owner cannot be removed from the workspace")
+	}
+	return s.repo.RemoveMember(id, memberId)
+}
+
+// authorize returns the workspace when the user holds at least the required role in it.
+func (s *WorkspaceService) authorize(userId string, id string, required string) (model.Workspace, error) {
+	return authorizeWorkspace(s.repo, userId, id, required)
+}
+
+// authorizeWorkspace retrieves a workspace and checks that the user holds at least the required role in it.
+func authorizeWorkspace(workspaces repository.Workspace, userId string, id string, required string) (model.Workspace, error) {
+	workspace, err := workspaces.GetById(userId, id)
+	if err != nil {
+		return model.Workspace{}, err
+	}
+	if !hasRole(workspace.Role(userId), required) {
+		return model.Workspace{}, fmt.Errorf("workspace action requires the %s role", required)
+	}
+	return workspace, nil
+}
+
+// hasRole reports whether a role grants at least the permissions of the required role.
+func hasRole(role string, required string) bool {
+	rank := map[string]int{
+		model.RoleMember: 1,
+		model.RoleAdmin:  2,
+		model.RoleOwner:  3,
+	}
+	return rank[role] > 0 && rank[role] >= rank[required]
+}
+
+// validateWorkspaceName checks the workspace name and returns it trimmed.
+func validateWorkspaceName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", errors.New("workspace name cannot be empty")
+	}
+	if len(name) > maxWorkspaceNameLength {
+		return "", fmt.Errorf("workspace name cannot be longer than %d characters", maxWorkspaceNameLength)
+	}
+	return name, nil
+}
+
+// validateMemberRole checks that a role can be granted to a member. Ownership cannot be granted.
+func validateMemberRole(role string) error {
+	if role != model.RoleAdmin && role != model.RoleMember {
+		return fmt.Errorf("role must be %q or %q", model.RoleAdmin, model.RoleMember)
+	}
+	return nil
+}
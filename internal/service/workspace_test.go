@@ -0,0 +1,125 @@
+package service
+
+import (
+	"TaskManager/internal/domain/model"
+	"TaskManager/internal/repository"
+	"errors"
+	"testing"
+)
+
+// fakeWorkspaces keeps workspaces in memory by ID.
+type fakeWorkspaces struct {
+	repository.Workspace
+	workspaces map[string]model.Workspace
+}
+
+func (f *fakeWorkspaces) GetById(userId string, id string) (model.Workspace, error) {
+	workspace, ok := f.workspaces[id]
+	if !ok || workspace.Role(userId) == "" {
+		return model.Workspace{}, errors.New("workspace not found")
+	}
+	return workspace, nil
+}
+
+func TestHasRole(t *testing.T) {
+	testTable := []struct {
+		name     string
+		role     string
+		required string
+		expected bool
+	}{
+		{
+			name:     "Owner Can Administer",
+			role:     model.RoleOwner,
+			required: model.RoleAdmin,
+			expected: true,
+		},
+		{
+			name:     "Admin Is Not Owner",
+			role:     model.RoleAdmin,
+			required: model.RoleOwner,
+			expected: false,
+		},
+		{
+			name:     "Member Is Member",
+			role:     model.RoleMember,
+			required: model.RoleMember,
+			expected: true,
+		},
+		{
+			name:     "Non Member",
+			role:     "",
+			required: model.RoleMember,
+			expected: false,
+		},
+	}
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			result := hasRole(tt.role, tt.required)
+			if result != tt.expected {
+				t.Errorf("hasRole(%s, %s) = %v, want %v", tt.role, tt.required, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestValidateMemberRole(t *testing.T) {
+	testTable := []struct {
+		name    string
+		role    string
+		wantErr bool
+	}{
+		{name: "Admin", role: model.RoleAdmin, wantErr: false},
+		{name: "Member", role: model.RoleMember, wantErr: false},
+		{name: "Owner Cannot Be Granted", role: model.RoleOwner, wantErr: true},
+		{name: "Unknown Role", role: "guest", wantErr: true},
+	}
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateMemberRole(tt.role)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateMemberRole(%s) error = %v, wantErr %v", tt.role, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAuthorizeListWrite(t *testing.T) {
+	workspaces := &fakeWorkspaces{workspaces: map[string]model.Workspace{
+		"ws": {Members: []model.WorkspaceMember{{UserId: "alice", Role: model.RoleOwner}, {UserId: "bob", Role: model.RoleMember}}},
+	}}
+
+	testTable := []struct {
+		name    string
+		userId  string
+		list    model.TaskList
+		wantErr bool
+	}{
+		{
+			name:    "Personal List",
+			userId:  "alice",
+			list:    model.TaskList{UserId: "alice"},
+			wantErr: false,
+		},
+		{
+			name:    "Workspace Member",
+			userId:  "bob",
+			list:    model.TaskList{UserId: "alice", WorkspaceId: "ws"},
+			wantErr: false,
+		},
+		{
+			name:    "Creator Who Left The Workspace",
+			userId:  "carol",
+			list:    model.TaskList{UserId: "carol", WorkspaceId: "ws"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			err := authorizeListWrite(workspaces, tt.userId, tt.list)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("authorizeListWrite() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
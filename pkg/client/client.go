@@ -0,0 +1,336 @@
+// Package client is a Go client for the TaskManager HTTP API.
+//
+// A Client logs in with Login and then sends the token with every request. It remembers the
+// credentials and logs in again shortly before the token expires, or when the server rejects it,
+// so long-running services do not have to handle token expiry themselves.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/golang-jwt/jwt/v5"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// apiPrefix is the path of the API version the client speaks.
+const apiPrefix = "/api/v1"
+
+// refreshMargin is how long before its expiry a token is replaced.
+const refreshMargin = time.Minute
+
+// Client calls the TaskManager HTTP API. It is safe for concurrent use.
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+
+	mu         sync.Mutex
+	token      string
+	expiresAt  time.Time
+	username   string
+	password   string
+	refreshing *tokenRefresh
+}
+
+// tokenRefresh is a login in progress to replace the token. Requests that need a new token
+// while it runs wait for it instead of logging in again.
+type tokenRefresh struct {
+	done  chan struct{}
+	token string
+	err   error
+}
+
+// Option configures a Client.
+type Option func(*Client)
+
+// WithHTTPClient sets the HTTP client used for requests. The default has a 30 second timeout.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithToken sets a token obtained earlier. Without credentials the client cannot refresh it.
+func WithToken(token string) Option {
+	return func(c *Client) {
+		c.setToken(token)
+	}
+}
+
+// New creates a client for the server at baseURL, for example "http://localhost:8080".
+func New(baseURL string, opts ...Option) *Client {
+	c := &Client{
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Token returns the current token, or an empty string before the first login.
+func (c *Client) Token() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.token
+}
+
+// Register creates a user and returns its ID.
+func (c *Client) Register(ctx context.Context, input RegisterInput) (int, error) {
+	var resp idResponse
+	if err := c.send(ctx, http.MethodPost, "/register", input, &resp); err != nil {
+		return 0, err
+	}
+	return resp.Id, nil
+}
+
+// Login obtains a token for the user and keeps the credentials to refresh it. For users with two-factor
+// authentication it returns a TwoFactorRequiredError; continue with LoginTwoFactor.
+func (c *Client) Login(ctx context.Context, username, password string) (string, error) {
+	token, err := c.login(ctx, username, password)
+	if err != nil {
+		return "", err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.username, c.password = username, password
+	c.setToken(token)
+	return token, nil
+}
+
+// LoginTwoFactor completes a login with a code from the authenticator app or a recovery code.
+// The token cannot be refreshed without another code; scripts should use a personal access token instead.
+func (c *Client) LoginTwoFactor(ctx context.Context, challenge, code string) (string, error) {
+	input := map[string]string{"challenge": challenge, "code": code}
+	var resp struct {
+		Token string `json:"token"`
+	}
+	if err := c.send(ctx, http.MethodPost, "/login/2fa", input, &resp); err != nil {
+		return "", err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.username, c.password = "", ""
+	c.setToken(resp.Token)
+	return resp.Token, nil
+}
+
+// ListTasks returns the user's task lists, optionally filtered by tags.
+func (c *Client) ListTasks(ctx context.Context, opts ListTasksOptions) ([]TaskList, error) {
+	query := url.Values{}
+	if len(opts.Tags) > 0 {
+		query.Set("tags", strings.Join(opts.Tags, ","))
+		if opts.MatchAllTags {
+			query.Set("tags_match", "all")
+		}
+	}
+	path := "/tasks"
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+
+	var resp struct {
+		Data []TaskList `json:"data"`
+	}
+	if err := c.do(ctx, http.MethodGet, path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Data, nil
+}
+
+// GetTask returns a single task list.
+func (c *Client) GetTask(ctx context.Context, id int) (TaskList, error) {
+	var list TaskList
+	if err := c.do(ctx, http.MethodGet, taskPath(id), nil, &list); err != nil {
+		return TaskList{}, err
+	}
+	return list, nil
+}
+
+// CreateTask creates a task list and returns its ID.
+func (c *Client) CreateTask(ctx context.Context, list TaskList) (int, error) {
+	var resp idResponse
+	if err := c.do(ctx, http.MethodPost, "/tasks", list, &resp); err != nil {
+		return 0, err
+	}
+	return resp.Id, nil
+}
+
+// UpdateTask changes the fields of a task list that are set in input.
+func (c *Client) UpdateTask(ctx context.Context, id int, input UpdateTaskInput) error {
+	return c.do(ctx, http.MethodPut, taskPath(id), input, nil)
+}
+
+// DeleteTask deletes a task list.
+func (c *Client) DeleteTask(ctx context.Context, id int) error {
+	return c.do(ctx, http.MethodDelete, taskPath(id), nil, nil)
+}
+
+type idResponse struct {
+	Id int `json:"id"`
+}
+
+func taskPath(id int) string {
+	return "/tasks/" + strconv.Itoa(id)
+}
+
+// login exchanges credentials for a token without changing the client's state.
+func (c *Client) login(ctx context.Context, username, password string) (string, error) {
+	input := map[string]string{"username": username, "password": password}
+	var resp struct {
+		Token             string `json:"token"`
+		TwoFactorRequired bool   `json:"two_factor_required"`
+		Challenge         string `json:"challenge"`
+	}
+	if err := c.send(ctx, http.MethodPost, "/login", input, &resp); err != nil {
+		return "", err
+	}
+	if resp.TwoFactorRequired {
+		return "", &TwoFactorRequiredError{Challenge: resp.Challenge}
+	}
+	return resp.Token, nil
+}
+
+// do sends an authenticated request. It refreshes a token that is about to expire beforehand,
+// and retries once with a new token when the server rejects the current one.
+func (c *Client) do(ctx context.Context, method, path string, body, out interface{}) error {
+	token, err := c.currentToken(ctx, "")
+	if err != nil {
+		return err
+	}
+	err = c.sendWithToken(ctx, method, path, token, body, out)
+	if !errors.Is(err, ErrUnauthorized) || !c.canRefresh() {
+		return err
+	}
+
+	if token, err = c.currentToken(ctx, token); err != nil {
+		return err
+	}
+	return c.sendWithToken(ctx, method, path, token, body, out)
+}
+
+// currentToken returns the token to send, logging in again first when it is about to expire or is the
+// rejected one. The login runs without holding c.mu, and concurrent callers share a single login.
+func (c *Client) currentToken(ctx context.Context, rejected string) (string, error) {
+	c.mu.Lock()
+	if c.username == "" {
+		token := c.token
+		c.mu.Unlock()
+		return token, nil
+	}
+	expiring := !c.expiresAt.IsZero() && time.Until(c.expiresAt) < refreshMargin
+	if c.token != "" && c.token != rejected && !expiring {
+		token := c.token
+		c.mu.Unlock()
+		return token, nil
+	}
+
+	refresh := c.refreshing
+	if refresh != nil {
+		c.mu.Unlock()
+		select {
+		case <-refresh.done:
+		case <-ctx.Done():
+			return "", ctx.Err()
+		}
+	} else {
+		refresh = &tokenRefresh{done: make(chan struct{})}
+		c.refreshing = refresh
+		username, password := c.username, c.password
+		c.mu.Unlock()
+
+		refresh.token, refresh.err = c.login(ctx, username, password)
+
+		c.mu.Lock()
+		c.refreshing = nil
+		// A Login during the refresh replaced the credentials, and its token wins.
+		if refresh.err == nil && c.username == username && c.password == password {
+			c.setToken(refresh.token)
+		}
+		c.mu.Unlock()
+		close(refresh.done)
+	}
+
+	if refresh.err != nil {
+		return "", fmt.Errorf("refreshing token: %w", refresh.err)
+	}
+	return refresh.token, nil
+}
+
+func (c *Client) canRefresh() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.username != ""
+}
+
+// setToken stores the token and its expiry. The caller must hold c.mu unless the client is not shared yet.
+func (c *Client) setToken(token string) {
+	c.token = token
+	c.expiresAt = time.Time{}
+
+	// The client cannot verify the signature; it only reads the expiry to refresh in time.
+	var claims jwt.RegisteredClaims
+	if _, _, err := jwt.NewParser().ParseUnverified(token, &claims); err == nil && claims.ExpiresAt != nil {
+		c.expiresAt = claims.ExpiresAt.Time
+	}
+}
+
+// send sends an unauthenticated request.
+func (c *Client) send(ctx context.Context, method, path string, body, out interface{}) error {
+	return c.sendWithToken(ctx, method, path, "", body, out)
+}
+
+// sendWithToken sends body as JSON and decodes the response into out when it is not nil.
+func (c *Client) sendWithToken(ctx context.Context, method, path, token string, body, out interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("encoding request: %w", err)
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+apiPrefix+path, reader)
+	if err != nil {
+		return fmt.Errorf("creating request: %w", err)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		apiErr := &Error{StatusCode: resp.StatusCode}
+		if err := json.NewDecoder(resp.Body).Decode(apiErr); err != nil || apiErr.Message == "" {
+			apiErr.Message = http.StatusText(resp.StatusCode)
+		}
+		return apiErr
+	}
+	if out == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("decoding response: %w", err)
+	}
+	return nil
+}
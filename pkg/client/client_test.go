@@ -0,0 +1,327 @@
+package client
+
+import (
+	"TaskManager/internal/domain/model"
+	"TaskManager/internal/handlers"
+	"TaskManager/internal/service"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/golang-jwt/jwt/v5"
+	"go.uber.org/zap"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+var testKey = []byte("test-key")
+
+// fakeAuth issues real JWTs with a configurable lifetime and can revoke them.
+type fakeAuth struct {
+	service.Authorization
+	mu      sync.Mutex
+	ttl     time.Duration
+	logins  int
+	revoked map[string]bool
+}
+
+func (f *fakeAuth) CreateUser(user model.User) (int, error) {
+	if user.Username == "taken" {
+		return 0, errors.New("username is taken")
+	}
+	return 42, nil
+}
+
+func (f *fakeAuth) GenerateToken(username, password string) (string, error) {
+	if password != "secret" {
+		return "", errors.New("invalid password")
+	}
+	if username == "bob" {
+		return "", &service.TwoFactorChallenge{Token: "challenge-bob"}
+	}
+	return f.issue(username)
+}
+
+func (f *fakeAuth) issue(username string) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.logins++
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
+		ID:        fmt.Sprint(f.logins),
+		Subject:   username,
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(f.ttl)),
+	}).SignedString(testKey)
+}
+
+func (f *fakeAuth) ParseToken(token string) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.revoked[token] {
+		return "", errors.New("token revoked")
+	}
+	var claims jwt.RegisteredClaims
+	if _, err := jwt.ParseWithClaims(token, &claims, func(*jwt.Token) (interface{}, error) { return testKey, nil }); err != nil {
+		return "", err
+	}
+	return claims.Subject, nil
+}
+
+func (f *fakeAuth) ParseTokenScopes(token string) (string, []string, error) {
+	userId, err := f.ParseToken(token)
+	return userId, model.Scopes, err
+}
+
+func (f *fakeAuth) loginCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.logins
+}
+
+type fakeTaskLists struct {
+	service.TaskList
+	lists   map[int]model.TaskList
+	filter  model.TaskListFilter
+	updated model.UpdateTaskListInput
+}
+
+func (f *fakeTaskLists) GetAll(userId string, filter model.TaskListFilter) ([]model.TaskList, error) {
+	f.filter = filter
+	var lists []model.TaskList
+	for id := 1; id <= len(f.lists); id++ {
+		lists = append(lists, f.lists[id])
+	}
+	return lists, nil
+}
+
+func (f *fakeTaskLists) GetById(userId string, listId int) (model.TaskList, error) {
+	list, ok := f.lists[listId]
+	if !ok {
+		return model.TaskList{}, errors.New("task list not found")
+	}
+	return list, nil
+}
+
+func (f *fakeTaskLists) Create(userId string, list model.TaskList) (int, error) {
+	list.Id = len(f.lists) + 1
+	list.UserId = userId
+	f.lists[list.Id] = list
+	return list.Id, nil
+}
+
+func (f *fakeTaskLists) Update(userId string, listId int, input model.UpdateTaskListInput) error {
+	f.updated = input
+	return nil
+}
+
+func (f *fakeTaskLists) Delete(userId string, listId int) error {
+	delete(f.lists, listId)
+	return nil
+}
+
+// fakeTwoFactor completes the logins of bob, the user with two-factor authentication.
+type fakeTwoFactor struct {
+	service.TwoFactor
+	auth *fakeAuth
+}
+
+func (f *fakeTwoFactor) VerifyTwoFactor(challenge, code string) (string, error) {
+	if challenge != "challenge-bob" || code != "123456" {
+		return "", service.ErrInvalidTwoFactorCode
+	}
+	return f.auth.issue("bob")
+}
+
+func newTestServer(t *testing.T, ttl time.Duration) (*httptest.Server, *fakeAuth, *fakeTaskLists) {
+	t.Helper()
+	auth := &fakeAuth{ttl: ttl, revoked: map[string]bool{}}
+	lists := &fakeTaskLists{lists: map[int]model.TaskList{}}
+	h := handlers.NewHandler(&service.Service{Authorization: auth, TwoFactor: &fakeTwoFactor{auth: auth}, TaskList: lists}, zap.NewNop(), handlers.Deprecation{})
+	server := httptest.NewServer(h.InitRoutes(zap.NewNop()))
+	t.Cleanup(server.Close)
+	return server, auth, lists
+}
+
+func TestClient_TaskLists(t *testing.T) {
+	server, _, lists := newTestServer(t, time.Hour)
+	ctx := context.Background()
+	c := New(server.URL)
+
+	if id, err := c.Register(ctx, RegisterInput{Username: "alice", Password: "secret"}); err != nil || id != 42 {
+		t.Fatalf("Register() = %d, %v", id, err)
+	}
+	if _, err := c.Login(ctx, "alice", "secret"); err != nil {
+		t.Fatalf("Login() error = %v", err)
+	}
+
+	due := time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)
+	id, err := c.CreateTask(ctx, TaskList{Title: "Release", Priority: PriorityHigh, DueDate: &due, Tags: []string{"work"}})
+	if err != nil || id != 1 {
+		t.Fatalf("CreateTask() = %d, %v", id, err)
+	}
+
+	list, err := c.GetTask(ctx, id)
+	if err != nil {
+		t.Fatalf("GetTask() error = %v", err)
+	}
+	if list.Title != "Release" || list.UserId != "alice" || list.DueDate == nil || !list.DueDate.Equal(due) {
+		t.Errorf("GetTask() = %+v", list)
+	}
+
+	all, err := c.ListTasks(ctx, ListTasksOptions{Tags: []string{"work", "q4"}, MatchAllTags: true})
+	if err != nil || len(all) != 1 {
+		t.Fatalf("ListTasks() = %v, %v", all, err)
+	}
+	if want := (model.TaskListFilter{Tags: []string{"work", "q4"}, MatchAllTags: true}); !reflect.DeepEqual(lists.filter, want) {
+		t.Errorf("server filter = %+v, want %+v", lists.filter, want)
+	}
+
+	status := StatusDone
+	if err := c.UpdateTask(ctx, id, UpdateTaskInput{Status: &status}); err != nil {
+		t.Fatalf("UpdateTask() error = %v", err)
+	}
+	if lists.updated.Status == nil || *lists.updated.Status != StatusDone || lists.updated.Title != nil {
+		t.Errorf("server update input = %+v, want only the status", lists.updated)
+	}
+
+	if err := c.DeleteTask(ctx, id); err != nil {
+		t.Fatalf("DeleteTask() error = %v", err)
+	}
+	if len(lists.lists) != 0 {
+		t.Errorf("lists after DeleteTask() = %v", lists.lists)
+	}
+}
+
+func TestClient_Errors(t *testing.T) {
+	server, _, _ := newTestServer(t, time.Hour)
+	ctx := context.Background()
+	c := New(server.URL)
+
+	_, err := c.GetTask(ctx, 1)
+	var apiErr *Error
+	if !errors.As(err, &apiErr) || !errors.Is(err, ErrUnauthorized) || apiErr.Message != "No authorization header provided" {
+		t.Errorf("GetTask() without login error = %v, want the server's 401 message", err)
+	}
+
+	if _, err := c.Login(ctx, "alice", "wrong"); !errors.Is(err, ErrServer) {
+		t.Errorf("Login() with a wrong password error = %v, want ErrServer", err)
+	}
+	if _, err := c.Register(ctx, RegisterInput{Username: "taken", Password: "secret"}); !errors.As(err, &apiErr) || apiErr.Message != "username is taken" {
+		t.Errorf("Register() error = %v, want the server's message", err)
+	}
+}
+
+func TestClient_RefreshesToken(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Expiring", func(t *testing.T) {
+		// Tokens that expire within the refresh margin are replaced before each request.
+		server, auth, _ := newTestServer(t, refreshMargin/2)
+		c := New(server.URL)
+		first, err := c.Login(ctx, "alice", "secret")
+		if err != nil {
+			t.Fatalf("Login() error = %v", err)
+		}
+		if _, err := c.ListTasks(ctx, ListTasksOptions{}); err != nil {
+			t.Fatalf("ListTasks() error = %v", err)
+		}
+		if auth.loginCount() != 2 || c.Token() == first {
+			t.Errorf("logins = %d, token changed = %v; want a refresh before the request", auth.loginCount(), c.Token() != first)
+		}
+	})
+
+	t.Run("Rejected", func(t *testing.T) {
+		// A token the server rejects is replaced and the request retried once.
+		server, auth, _ := newTestServer(t, time.Hour)
+		c := New(server.URL)
+		first, err := c.Login(ctx, "alice", "secret")
+		if err != nil {
+			t.Fatalf("Login() error = %v", err)
+		}
+		auth.mu.Lock()
+		auth.revoked[first] = true
+		auth.mu.Unlock()
+
+		if _, err := c.ListTasks(ctx, ListTasksOptions{}); err != nil {
+			t.Fatalf("ListTasks() error = %v", err)
+		}
+		if auth.loginCount() != 2 || c.Token() == first {
+			t.Errorf("logins = %d, token changed = %v; want one refresh", auth.loginCount(), c.Token() != first)
+		}
+	})
+
+	t.Run("ConcurrentRejected", func(t *testing.T) {
+		// Requests rejected together share a single login.
+		server, auth, lists := newTestServer(t, time.Hour)
+		lists.lists[1] = model.TaskList{Id: 1, Title: "Release"}
+		c := New(server.URL)
+		first, err := c.Login(ctx, "alice", "secret")
+		if err != nil {
+			t.Fatalf("Login() error = %v", err)
+		}
+		auth.mu.Lock()
+		auth.revoked[first] = true
+		auth.mu.Unlock()
+
+		var wg sync.WaitGroup
+		errs := make(chan error, 8)
+		for range 8 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := c.GetTask(ctx, 1)
+				errs <- err
+			}()
+		}
+		wg.Wait()
+		close(errs)
+		for err := range errs {
+			if err != nil {
+				t.Fatalf("GetTask() error = %v", err)
+			}
+		}
+		if auth.loginCount() != 2 {
+			t.Errorf("logins = %d, want one refresh", auth.loginCount())
+		}
+	})
+
+	t.Run("WithoutCredentials", func(t *testing.T) {
+		server, auth, _ := newTestServer(t, time.Hour)
+		token, err := New(server.URL).Login(ctx, "alice", "secret")
+		if err != nil {
+			t.Fatalf("Login() error = %v", err)
+		}
+		auth.revoked[token] = true
+
+		c := New(server.URL, WithToken(token))
+		if _, err := c.ListTasks(ctx, ListTasksOptions{}); !errors.Is(err, ErrUnauthorized) {
+			t.Errorf("ListTasks() error = %v, want ErrUnauthorized", err)
+		}
+		if auth.loginCount() != 1 {
+			t.Errorf("logins = %d, want no refresh without credentials", auth.loginCount())
+		}
+	})
+}
+
+func TestClient_LoginTwoFactor(t *testing.T) {
+	server, _, _ := newTestServer(t, time.Hour)
+	ctx := context.Background()
+	c := New(server.URL)
+
+	_, err := c.Login(ctx, "bob", "secret")
+	var twoFactor *TwoFactorRequiredError
+	if !errors.As(err, &twoFactor) || !errors.Is(err, ErrTwoFactorRequired) || twoFactor.Challenge != "challenge-bob" {
+		t.Fatalf("Login() error = %v, want a TwoFactorRequiredError", err)
+	}
+	if _, err := c.LoginTwoFactor(ctx, twoFactor.Challenge, "000000"); !errors.Is(err, ErrUnauthorized) {
+		t.Errorf("LoginTwoFactor() with a wrong code error = %v, want ErrUnauthorized", err)
+	}
+	token, err := c.LoginTwoFactor(ctx, twoFactor.Challenge, "123456")
+	if err != nil || token == "" || c.Token() != token {
+		t.Fatalf("LoginTwoFactor() = %q, %v, want the token to be used", token, err)
+	}
+	if _, err := c.ListTasks(ctx, ListTasksOptions{}); err != nil {
+		t.Errorf("ListTasks() after LoginTwoFactor() error = %v", err)
+	}
+}
@@ -0,0 +1,59 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// Errors for the status classes of API responses, for use with errors.Is.
+var (
+	ErrBadRequest   = errors.New("bad request")
+	ErrUnauthorized = errors.New("unauthorized")
+	ErrForbidden    = errors.New("forbidden")
+	ErrNotFound     = errors.New("not found")
+	ErrServer       = errors.New("server error")
+	// ErrTwoFactorRequired is returned by Login, as a TwoFactorRequiredError, for users with two-factor authentication.
+	ErrTwoFactorRequired = errors.New("two-factor authentication required")
+)
+
+// TwoFactorRequiredError is returned by Login when the password was accepted but a code is still needed.
+// Pass its challenge to LoginTwoFactor together with the code.
+type TwoFactorRequiredError struct {
+	Challenge string
+}
+
+func (e *TwoFactorRequiredError) Error() string {
+	return "taskmanager: " + ErrTwoFactorRequired.Error()
+}
+
+func (e *TwoFactorRequiredError) Unwrap() error {
+	return ErrTwoFactorRequired
+}
+
+// Error is an error response of the API. It carries the message the server returned.
+type Error struct {
+	StatusCode int
+	Message    string `json:"message"`
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("taskmanager: %s (HTTP %d)", e.Message, e.StatusCode)
+}
+
+// Is reports whether the error belongs to the status class of target.
+func (e *Error) Is(target error) bool {
+	switch target {
+	case ErrBadRequest:
+		return e.StatusCode == http.StatusBadRequest
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrServer:
+		return e.StatusCode >= http.StatusInternalServerError
+	}
+	return false
+}
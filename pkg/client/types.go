@@ -0,0 +1,80 @@
+package client
+
+import "time"
+
+// Task list statuses.
+const (
+	StatusTodo = "todo"
+	StatusDone = "done"
+)
+
+// Task list priorities.
+const (
+	PriorityLow    = "low"
+	PriorityMedium = "medium"
+	PriorityHigh   = "high"
+)
+
+// RegisterInput holds the details of a new user.
+type RegisterInput struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+	Email    string `json:"email,omitempty"`
+}
+
+// TaskList is a task list as returned by the API.
+type TaskList struct {
+	Id              int         `json:"id"`
+	UserId          string      `json:"user_id"`
+	WorkspaceId     string      `json:"workspace_id,omitempty"`
+	Title           string      `json:"title"`
+	Description     string      `json:"description"`
+	Status          string      `json:"status"`
+	Priority        string      `json:"priority"`
+	DueDate         *time.Time  `json:"due_date,omitempty"`
+	CompletedAt     *time.Time  `json:"completed_at,omitempty"`
+	CreatedAt       *time.Time  `json:"created_at,omitempty"`
+	Recurrence      *Recurrence `json:"recurrence,omitempty"`
+	Tags            []string    `json:"tags,omitempty"`
+	BoardId         string      `json:"board_id,omitempty"`
+	ColumnId        string      `json:"column_id,omitempty"`
+	Position        string      `json:"position,omitempty"`
+	ReminderOffsets []int       `json:"reminder_offsets,omitempty"`
+	DependsOn       []int       `json:"depends_on,omitempty"`
+	Items           []TaskItem  `json:"items,omitempty"`
+}
+
+// TaskItem is a subtask of a task list.
+type TaskItem struct {
+	Id        string   `json:"id"`
+	Title     string   `json:"title"`
+	Done      bool     `json:"done"`
+	DependsOn []string `json:"depends_on,omitempty"`
+}
+
+// Recurrence describes how a task list repeats using an iCalendar RRULE.
+type Recurrence struct {
+	RRule    string    `json:"rrule"`
+	Start    time.Time `json:"start"`
+	SeriesId int       `json:"series_id"`
+	NextId   int       `json:"next_id,omitempty"`
+}
+
+// UpdateTaskInput holds the fields to change in UpdateTask. Nil fields are left unchanged.
+type UpdateTaskInput struct {
+	Title           *string    `json:"title,omitempty"`
+	Description     *string    `json:"description,omitempty"`
+	Status          *string    `json:"status,omitempty"`
+	Priority        *string    `json:"priority,omitempty"`
+	DueDate         *time.Time `json:"due_date,omitempty"`
+	ReminderOffsets *[]int     `json:"reminder_offsets,omitempty"`
+	// WorkspaceId moves the list into a workspace, or back to its owner when empty.
+	WorkspaceId *string `json:"workspace_id,omitempty"`
+}
+
+// ListTasksOptions filters the task lists returned by ListTasks.
+type ListTasksOptions struct {
+	Tags []string
+	// MatchAllTags requires every tag instead of any of them.
+	MatchAllTags bool
+}